@@ -44,12 +44,18 @@ func main() {
 	// Initialize repositories
 	playerRepo := repository.NewPlayerRepository(pool)
 	venueRepo := repository.NewVenueRepository(pool)
+	courtRepo := repository.NewCourtRepository(pool)
 	matchRepo := repository.NewMatchRepository(pool)
 	tendenciesRepo := repository.NewTendenciesRepository(pool)
+	ratingRepo := repository.NewRatingRepository(pool)
+	tournamentRepo := repository.NewTournamentRepository(pool)
 
 	// Initialize services
-	matchSvc := service.NewMatchService(matchRepo, playerRepo, venueRepo)
-	tendenciesSvc := service.NewTendenciesService(tendenciesRepo, venueRepo)
+	ratingSvc := service.NewRatingService(ratingRepo, playerRepo)
+	matchSvc := service.NewMatchService(matchRepo, playerRepo, venueRepo, courtRepo, ratingSvc, tournamentRepo)
+	tendenciesSvc := service.NewTendenciesService(tendenciesRepo, venueRepo, courtRepo)
+	sessionSvc := service.NewSessionService(matchSvc, matchRepo)
+	tournamentSvc := service.NewTournamentService(tournamentRepo, playerRepo, venueRepo, matchSvc, ratingSvc)
 
 	// Initialize auth
 	jwtService := auth.NewJWTService(cfg.JWTSecret)
@@ -61,13 +67,18 @@ func main() {
 	authHandler := handler.NewAuthHandler(jwtService, cfg.AdminUsername, cfg.AdminPasswordHash, isSecure)
 	playerHandler := handler.NewPlayerHandler(playerRepo)
 	venueHandler := handler.NewVenueHandler(venueRepo)
+	courtHandler := handler.NewCourtHandler(courtRepo, venueRepo)
 	matchHandler := handler.NewMatchHandler(matchSvc, matchRepo)
 	tendenciesHandler := handler.NewTendenciesHandler(tendenciesSvc)
+	sessionHandler := handler.NewSessionHandler(sessionSvc)
+	ratingHandler := handler.NewRatingHandler(ratingSvc)
+	tournamentHandler := handler.NewTournamentHandler(tournamentSvc)
 
 	// Initialize middleware
 	authMiddleware := middleware.NewAuthMiddleware(jwtService)
 	corsMiddleware := middleware.NewCORS(cfg.GetAllowedOrigins())
 	loginRateLimiter := middleware.NewRateLimiter(0.5, 5) // 1 request per 2 seconds, burst of 5
+	streamConnLimiter := middleware.NewConnLimiter(5)     // Concurrent live streams per IP
 
 	// Setup router
 	mux := http.NewServeMux()
@@ -104,14 +115,85 @@ func main() {
 			handler.WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
 		}
 	})))
-	mux.Handle("/api/admin/venues/", authMiddleware.RequireAuth(http.HandlerFunc(venueHandler.Update)))
+	mux.Handle("/api/admin/venues/", authMiddleware.RequireAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		switch {
+		case strings.Contains(path, "/courts/"):
+			switch r.Method {
+			case http.MethodPatch:
+				courtHandler.Update(w, r)
+			case http.MethodDelete:
+				courtHandler.Delete(w, r)
+			default:
+				handler.WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+			}
+		case strings.HasSuffix(path, "/courts"):
+			switch r.Method {
+			case http.MethodGet:
+				courtHandler.List(w, r)
+			case http.MethodPost:
+				courtHandler.Create(w, r)
+			default:
+				handler.WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+			}
+		default:
+			venueHandler.Update(w, r)
+		}
+	})))
 	mux.Handle("/api/admin/matches/", authMiddleware.RequireAuth(http.HandlerFunc(matchHandler.Delete)))
 	mux.Handle("/api/admin/matches", authMiddleware.RequireAuth(http.HandlerFunc(matchHandler.List)))
+	mux.Handle("/api/admin/export", authMiddleware.RequireAuth(http.HandlerFunc(matchHandler.Export)))
+	mux.Handle("/api/admin/import", authMiddleware.RequireAuth(http.HandlerFunc(matchHandler.Import)))
+	mux.Handle("/api/admin/ratings/recompute", authMiddleware.RequireAuth(http.HandlerFunc(ratingHandler.Recompute)))
+
+	// Exports include every point of the player's matches, so they need an admin
+	playerExport := authMiddleware.RequireAuth(http.HandlerFunc(matchHandler.PlayerExport))
 
 	// Public routes
 	mux.HandleFunc("/api/players", playerHandler.List)
+	mux.HandleFunc("/api/players/", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/export"):
+			playerExport.ServeHTTP(w, r)
+		case strings.HasSuffix(r.URL.Path, "/profile"):
+			matchHandler.PlayerProfile(w, r)
+		case strings.HasSuffix(r.URL.Path, "/rating-history"):
+			ratingHandler.History(w, r)
+		case strings.HasSuffix(r.URL.Path, "/partners"):
+			ratingHandler.PlayerPartners(w, r)
+		default:
+			handler.WriteError(w, http.StatusNotFound, "not found")
+		}
+	})
 	mux.HandleFunc("/api/venues", venueHandler.List)
-	mux.HandleFunc("/api/matches", matchHandler.Create)
+	mux.HandleFunc("/api/matches", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			matchHandler.List(w, r)
+		case http.MethodPost:
+			matchHandler.Create(w, r)
+		default:
+			handler.WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+	})
+	mux.HandleFunc("/api/schedule", matchHandler.Schedule)
+	mux.HandleFunc("/api/head-to-head", matchHandler.HeadToHead)
+	mux.HandleFunc("/api/ratings", ratingHandler.List)
+	mux.HandleFunc("/api/partnerships", ratingHandler.Partnerships)
+	mux.HandleFunc("/api/tournaments", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			tournamentHandler.List(w, r)
+		case http.MethodPost:
+			tournamentHandler.Create(w, r)
+		default:
+			handler.WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+	})
+
+	// Live event streams (limited per client IP)
+	matchStream := streamConnLimiter.Limit(http.HandlerFunc(matchHandler.Stream))
+	venueStream := streamConnLimiter.Limit(http.HandlerFunc(matchHandler.VenueStream))
 
 	// Venue-specific routes need path parsing
 	mux.HandleFunc("/api/venues/", func(w http.ResponseWriter, r *http.Request) {
@@ -119,21 +201,94 @@ func main() {
 		switch {
 		case strings.HasSuffix(path, "/tendencies"):
 			tendenciesHandler.GetVenueTendencies(w, r)
+		case strings.HasSuffix(path, "/tendencies/breakdown"):
+			tendenciesHandler.GetVenueTendencyBreakdown(w, r)
+		case strings.HasSuffix(path, "/courts"):
+			courtHandler.List(w, r)
+		case strings.HasSuffix(path, "/pace"):
+			matchHandler.VenuePace(w, r)
+		case strings.HasSuffix(path, "/stream"):
+			venueStream.ServeHTTP(w, r)
 		default:
 			handler.WriteError(w, http.StatusNotFound, "not found")
 		}
 	})
 
+	// Tournament-specific routes need path parsing
+	mux.HandleFunc("/api/tournaments/", func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		switch {
+		case strings.HasSuffix(path, "/teams"):
+			tournamentHandler.SetTeams(w, r)
+		case strings.HasSuffix(path, "/matches"):
+			tournamentHandler.Matches(w, r)
+		case strings.HasSuffix(path, "/next-match"):
+			tournamentHandler.NextMatch(w, r)
+		case strings.HasSuffix(path, "/result") && strings.Contains(path, "/matches/"):
+			tournamentHandler.RecordResult(w, r)
+		case strings.HasSuffix(path, "/start") && strings.Contains(path, "/matches/"):
+			tournamentHandler.StartMatch(w, r)
+		case strings.HasSuffix(path, "/advance"):
+			tournamentHandler.Advance(w, r)
+		case strings.HasSuffix(path, "/final"):
+			tournamentHandler.PrepareFinal(w, r)
+		case strings.HasSuffix(path, "/standings"):
+			tournamentHandler.Standings(w, r)
+		case strings.Count(strings.Trim(path, "/"), "/") == 2:
+			tournamentHandler.Get(w, r)
+		default:
+			handler.WriteError(w, http.StatusNotFound, "not found")
+		}
+	})
+
+	// Voiding a recorded point or a match's result rewrites the match, so it
+	// needs an admin
+	voidEvent := authMiddleware.RequireAuth(http.HandlerFunc(matchHandler.VoidEvent))
+	voidMatch := authMiddleware.RequireAuth(http.HandlerFunc(matchHandler.Void))
+
+	// Recording a result without points can't be checked, so it needs an admin
+	recordResult := authMiddleware.RequireAuth(http.HandlerFunc(matchHandler.RecordResult))
+
 	// Match-specific routes need path parsing
 	mux.HandleFunc("/api/matches/", func(w http.ResponseWriter, r *http.Request) {
 		path := r.URL.Path
 		switch {
+		case path == "/api/matches/results":
+			recordResult.ServeHTTP(w, r)
 		case strings.HasSuffix(path, "/events"):
 			matchHandler.AddEvents(w, r)
+		case strings.Contains(path, "/events/"):
+			voidEvent.ServeHTTP(w, r)
+		case strings.HasSuffix(path, "/start"):
+			matchHandler.Start(w, r)
 		case strings.HasSuffix(path, "/complete"):
 			matchHandler.Complete(w, r)
+		case strings.HasSuffix(path, "/suspend"):
+			matchHandler.Suspend(w, r)
+		case strings.HasSuffix(path, "/resume"):
+			matchHandler.Resume(w, r)
+		case strings.HasSuffix(path, "/abandon"):
+			matchHandler.Abandon(w, r)
+		case strings.HasSuffix(path, "/void"):
+			voidMatch.ServeHTTP(w, r)
 		case strings.HasSuffix(path, "/summary"):
 			matchHandler.Summary(w, r)
+		case strings.HasSuffix(path, "/scorecard"):
+			matchHandler.Scorecard(w, r)
+		case strings.HasSuffix(path, "/pace"):
+			matchHandler.Pace(w, r)
+		case strings.HasSuffix(path, "/timeline"):
+			matchHandler.Timeline(w, r)
+		case strings.HasSuffix(path, "/state"):
+			matchHandler.State(w, r)
+		case strings.HasSuffix(path, "/stream"):
+			matchStream.ServeHTTP(w, r)
+		case strings.HasSuffix(path, "/session"):
+			sessionHandler.Poll(w, r)
+		case strings.HasSuffix(path, "/session/points"):
+			sessionHandler.Submit(w, r)
+		case strings.HasSuffix(path, "/resolve") && strings.Contains(path, "/session/conflicts/"):
+			sessionHandler.Resolve(w, r)
 		default:
 			handler.WriteError(w, http.StatusNotFound, "not found")
 		}
@@ -0,0 +1,258 @@
+// Command otsctl runs maintenance tasks against the OTS database.
+//
+// Usage:
+//
+//	otsctl export [-format csv|json|ndjson] [-venue ID] [-player ID] [-from YYYY-MM-DD] [-to YYYY-MM-DD] [-o FILE]
+//	otsctl import [-dry-run] FILE
+//	otsctl recompute-ratings
+//	otsctl backfill-results
+//
+// The database is read from DATABASE_URL.
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/database"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/service"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var err error
+	switch os.Args[1] {
+	case "export":
+		err = runExport(ctx, os.Args[2:])
+	case "import":
+		err = runImport(ctx, os.Args[2:])
+	case "recompute-ratings":
+		err = runRecomputeRatings(ctx, os.Args[2:])
+	case "backfill-results":
+		err = runBackfillResults(ctx, os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		usage()
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "otsctl: unknown command %q\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "otsctl: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: otsctl <command> [flags]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "commands:")
+	fmt.Fprintln(os.Stderr, "  export   write matches with their points and stats")
+	fmt.Fprintln(os.Stderr, "  import   load matches from point-by-point NDJSON (- reads stdin)")
+	fmt.Fprintln(os.Stderr, "  recompute-ratings")
+	fmt.Fprintln(os.Stderr, "           rebuild player ratings after old matches were edited or deleted")
+	fmt.Fprintln(os.Stderr, "  backfill-results")
+	fmt.Fprintln(os.Stderr, "           store the winner and score of completed matches that have none")
+}
+
+// connect opens the database named by DATABASE_URL and builds the match
+// and rating services.
+func connect(ctx context.Context) (*service.MatchService, *service.RatingService, func(), error) {
+	url := os.Getenv("DATABASE_URL")
+	if url == "" {
+		return nil, nil, nil, fmt.Errorf("DATABASE_URL environment variable is required")
+	}
+
+	pool, err := database.Connect(ctx, url)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	playerRepo := repository.NewPlayerRepository(pool)
+	ratings := service.NewRatingService(repository.NewRatingRepository(pool), playerRepo)
+	svc := service.NewMatchService(
+		repository.NewMatchRepository(pool),
+		playerRepo,
+		repository.NewVenueRepository(pool),
+		repository.NewCourtRepository(pool),
+		ratings,
+		repository.NewTournamentRepository(pool),
+	)
+	return svc, ratings, pool.Close, nil
+}
+
+func runExport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	formatName := fs.String("format", "csv", "output format: csv, json or ndjson")
+	venue := fs.String("venue", "", "only matches at this venue ID")
+	player := fs.String("player", "", "only matches this player ID took part in")
+	from := fs.String("from", "", "only matches started on or after this date (YYYY-MM-DD)")
+	to := fs.String("to", "", "only matches started on or before this date (YYYY-MM-DD)")
+	output := fs.String("o", "", "write to this file instead of stdout")
+	fs.Parse(args)
+
+	format, err := service.ParseExportFormat(*formatName)
+	if err != nil {
+		return err
+	}
+
+	var filter service.MatchHistoryFilter
+	if filter.VenueID, err = parseOptionalID(*venue, "venue"); err != nil {
+		return err
+	}
+	if filter.PlayerID, err = parseOptionalID(*player, "player"); err != nil {
+		return err
+	}
+	if filter.From, err = parseOptionalDate(*from, "from", 0); err != nil {
+		return err
+	}
+	if filter.To, err = parseOptionalDate(*to, "to", 1); err != nil {
+		return err
+	}
+
+	svc, _, closeDB, err := connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	bw := bufio.NewWriter(w)
+	if err := svc.ExportMatches(ctx, filter, format, bw); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func runImport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "validate the file without importing it")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		return fmt.Errorf("import takes one file")
+	}
+
+	var r io.Reader = os.Stdin
+	if name := fs.Arg(0); name != "-" {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	svc, _, closeDB, err := connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	result, err := svc.ImportMatches(ctx, r, *dryRun)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range result.Errors {
+		fmt.Fprintf(os.Stderr, "line %d: %s\n", e.Line, e.Error)
+	}
+	if len(result.Errors) > 0 {
+		return fmt.Errorf("%d of %d lines invalid; nothing imported", len(result.Errors), result.Lines)
+	}
+	if *dryRun {
+		fmt.Printf("%d matches valid\n", result.Lines)
+		return nil
+	}
+	for _, id := range result.MatchIDs {
+		fmt.Println(id)
+	}
+	fmt.Fprintf(os.Stderr, "imported %d matches\n", result.Imported)
+	return nil
+}
+
+func runRecomputeRatings(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("recompute-ratings", flag.ExitOnError)
+	fs.Parse(args)
+
+	_, ratings, closeDB, err := connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	if err := ratings.Recompute(ctx); err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stderr, "ratings recomputed")
+	return nil
+}
+
+func runBackfillResults(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("backfill-results", flag.ExitOnError)
+	fs.Parse(args)
+
+	svc, _, closeDB, err := connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	stored, err := svc.BackfillResults(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "stored %d match results\n", stored)
+	return nil
+}
+
+// parseOptionalID parses a UUID flag, returning nil if it is empty.
+func parseOptionalID(value, name string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid -%s: %w", name, err)
+	}
+	return &id, nil
+}
+
+// parseOptionalDate parses a YYYY-MM-DD flag as midnight UTC, moved on by
+// addDays, returning nil if it is empty.
+func parseOptionalDate(value, name string, addDays int) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	day, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, fmt.Errorf("-%s must be YYYY-MM-DD", name)
+	}
+	day = day.AddDate(0, 0, addDays)
+	return &day, nil
+}
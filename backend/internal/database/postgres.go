@@ -45,6 +45,23 @@ func RunMigrations(ctx context.Context, pool *pgxpool.Pool) error {
 		createMatchPlayersTable,
 		createPointEventsTable,
 		alterMatchTypeConstraint, // Add support for '1v2' (Australian Doubles)
+		addMatchModeColumns,
+		createPointConflictsTable,
+		addPointEventSeq,
+		addMatchStatus,
+		createMatchSuspensionsTable,
+		createCourtsTable,
+		addMatchScheduleColumns,
+		addCourtAttributes,
+		addMatchResult,
+		addImportSupport,
+		addResultOnlyMatches,
+		createPlayerRatingsTables,
+		createTournamentTables,
+		addTournamentTieBreaks,
+		addMatchBestOf,
+		createVoidedPointsTable,
+		addTournamentTeamSeed,
 	}
 
 	for i, migration := range migrations {
@@ -139,3 +156,280 @@ EXCEPTION
         NULL; -- Constraint already exists with correct definition
 END $$;
 `
+
+// Migration to store the scoring mode and short-format serving order so
+// matches can be replayed server-side
+const addMatchModeColumns = `
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS mode VARCHAR(20) NOT NULL DEFAULT 'standard'
+    CHECK (mode IN ('standard', 'short'));
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS servers UUID[];
+`
+
+const createPointConflictsTable = `
+CREATE TABLE IF NOT EXISTS point_conflicts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    device_id VARCHAR(100) NOT NULL,
+    position INTEGER NOT NULL,
+    canonical_event_id UUID NOT NULL,
+    proposed_event_id UUID NOT NULL,
+    proposed_timestamp TIMESTAMP WITH TIME ZONE NOT NULL,
+    proposed_server_player_id UUID NOT NULL REFERENCES players(id),
+    proposed_serve_type VARCHAR(20) NOT NULL CHECK (proposed_serve_type IN ('first', 'second', 'double_fault')),
+    proposed_winner_team CHAR(1) NOT NULL CHECK (proposed_winner_team IN ('A', 'B')),
+    resolution VARCHAR(20) CHECK (resolution IN ('keep_canonical', 'accept_proposed')),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_point_conflicts_open ON point_conflicts(match_id) WHERE resolved_at IS NULL;
+`
+
+// Migration to order point events by a per-match sequence number instead of
+// the scoring device's clock. Existing events are numbered by timestamp.
+const addPointEventSeq = `
+ALTER TABLE point_events ADD COLUMN IF NOT EXISTS seq INTEGER;
+
+UPDATE point_events pe
+SET seq = numbered.seq
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY match_id ORDER BY timestamp, created_at, id) AS seq
+    FROM point_events
+) numbered
+WHERE pe.id = numbered.id AND pe.seq IS NULL;
+
+ALTER TABLE point_events ALTER COLUMN seq SET NOT NULL;
+
+DO $$
+BEGIN
+    -- Two devices can't claim the same sequence number
+    ALTER TABLE point_events ADD CONSTRAINT point_events_match_seq_key
+        UNIQUE (match_id, seq);
+EXCEPTION
+    WHEN duplicate_table OR duplicate_object THEN
+        NULL; -- Constraint already exists
+END $$;
+`
+
+// Migration to track the match lifecycle explicitly. Matches that already
+// ended are marked completed.
+const addMatchStatus = `
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS status VARCHAR(20);
+
+UPDATE matches
+SET status = CASE WHEN ended_at IS NULL THEN 'in_progress' ELSE 'completed' END
+WHERE status IS NULL;
+
+ALTER TABLE matches ALTER COLUMN status SET DEFAULT 'in_progress';
+ALTER TABLE matches ALTER COLUMN status SET NOT NULL;
+
+DO $$
+BEGIN
+    ALTER TABLE matches ADD CONSTRAINT matches_status_check
+        CHECK (status IN ('scheduled', 'in_progress', 'suspended', 'completed', 'abandoned'));
+EXCEPTION
+    WHEN duplicate_object THEN
+        NULL; -- Constraint already exists
+END $$;
+
+CREATE INDEX IF NOT EXISTS idx_matches_status ON matches(status);
+`
+
+const createMatchSuspensionsTable = `
+CREATE TABLE IF NOT EXISTS match_suspensions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    reason VARCHAR(200) NOT NULL DEFAULT '',
+    suspended_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    resumed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_match_suspensions_match ON match_suspensions(match_id);
+`
+
+const createCourtsTable = `
+CREATE TABLE IF NOT EXISTS courts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    venue_id UUID NOT NULL REFERENCES venues(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (venue_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_courts_venue ON courts(venue_id);
+`
+
+// Migration to let matches be planned ahead of time on a court
+const addMatchScheduleColumns = `
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS court_id UUID REFERENCES courts(id) ON DELETE SET NULL;
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS scheduled_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_matches_court ON matches(court_id);
+CREATE INDEX IF NOT EXISTS idx_matches_scheduled_at ON matches(scheduled_at) WHERE scheduled_at IS NOT NULL;
+`
+
+// Migration to describe each court. A NULL surface means the venue's surface.
+const addCourtAttributes = `
+ALTER TABLE courts ADD COLUMN IF NOT EXISTS surface VARCHAR(20)
+    CHECK (surface IN ('hard', 'clay', 'grass'));
+ALTER TABLE courts ADD COLUMN IF NOT EXISTS indoor BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE courts ADD COLUMN IF NOT EXISTS lights BOOLEAN NOT NULL DEFAULT false;
+`
+
+// Migration to store the result of completed matches for match history.
+// Matches completed earlier are filled in by otsctl backfill-results.
+const addMatchResult = `
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS winner_team VARCHAR(1)
+    CHECK (winner_team IN ('A', 'B'));
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS score VARCHAR(100);
+CREATE INDEX IF NOT EXISTS idx_matches_history ON matches(started_at DESC, id DESC);
+`
+
+// Migration for matches imported from point-by-point scorecards: optional
+// tie-breaks, and points whose serve (first or second) was not recorded.
+const addImportSupport = `
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS tie_breaks BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE point_events DROP CONSTRAINT IF EXISTS point_events_serve_type_check;
+
+DO $$
+BEGIN
+    ALTER TABLE point_events ADD CONSTRAINT point_events_serve_type_known
+        CHECK (serve_type IN ('first', 'second', 'double_fault', 'unknown'));
+EXCEPTION
+    WHEN duplicate_object THEN
+        NULL; -- Constraint already exists
+END $$;
+`
+
+const addResultOnlyMatches = `
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS result_only BOOLEAN NOT NULL DEFAULT false;
+`
+
+// Glicko-2 ratings are derived from completed matches and can be rebuilt
+// from them at any time
+const createPlayerRatingsTables = `
+CREATE TABLE IF NOT EXISTS player_ratings (
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    kind VARCHAR(10) NOT NULL CHECK (kind IN ('singles', 'doubles')),
+    rating DOUBLE PRECISION NOT NULL,
+    deviation DOUBLE PRECISION NOT NULL,
+    volatility DOUBLE PRECISION NOT NULL,
+    matches INTEGER NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (player_id, kind)
+);
+
+CREATE TABLE IF NOT EXISTS player_rating_history (
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    kind VARCHAR(10) NOT NULL CHECK (kind IN ('singles', 'doubles')),
+    played_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    rating_before DOUBLE PRECISION NOT NULL,
+    rating DOUBLE PRECISION NOT NULL,
+    deviation DOUBLE PRECISION NOT NULL,
+    volatility DOUBLE PRECISION NOT NULL,
+    PRIMARY KEY (player_id, match_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_rating_history_match ON player_rating_history(match_id);
+CREATE INDEX IF NOT EXISTS idx_player_rating_history_played ON player_rating_history(played_at);
+`
+
+// Tournaments are saved whole from the engine's TournamentState; version
+// guards against two devices saving over each other
+const createTournamentTables = `
+CREATE TABLE IF NOT EXISTS tournaments (
+    id UUID PRIMARY KEY,
+    venue_id UUID NOT NULL REFERENCES venues(id),
+    player_ids UUID[] NOT NULL,
+    stage VARCHAR(20) NOT NULL CHECK (stage IN ('setup', 'round_robin', 'knockout', 'completed')),
+    winner_team_id UUID, -- A tournament_teams id; no FK as teams are replaced on save
+    completed BOOLEAN NOT NULL DEFAULT false,
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_tournaments_venue ON tournaments(venue_id);
+CREATE INDEX IF NOT EXISTS idx_tournaments_created ON tournaments(created_at DESC);
+
+CREATE TABLE IF NOT EXISTS tournament_teams (
+    id UUID PRIMARY KEY,
+    tournament_id UUID NOT NULL REFERENCES tournaments(id) ON DELETE CASCADE,
+    team_number INTEGER NOT NULL,
+    player1_id UUID NOT NULL REFERENCES players(id),
+    player2_id UUID NOT NULL REFERENCES players(id),
+    UNIQUE (tournament_id, team_number)
+);
+
+CREATE TABLE IF NOT EXISTS tournament_matches (
+    id UUID PRIMARY KEY,
+    tournament_id UUID NOT NULL REFERENCES tournaments(id) ON DELETE CASCADE,
+    stage VARCHAR(20) NOT NULL CHECK (stage IN ('round_robin', 'semi', 'final')),
+    position INTEGER NOT NULL, -- Order within the engine's match list
+    match_order INTEGER NOT NULL,
+    team_a_id UUID REFERENCES tournament_teams(id) ON DELETE CASCADE, -- NULL until known
+    team_b_id UUID REFERENCES tournament_teams(id) ON DELETE CASCADE,
+    scoring_match_id UUID REFERENCES matches(id) ON DELETE SET NULL,
+    winner_team_id UUID REFERENCES tournament_teams(id) ON DELETE CASCADE,
+    completed BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE INDEX IF NOT EXISTS idx_tournament_matches_tournament ON tournament_matches(tournament_id);
+CREATE INDEX IF NOT EXISTS idx_tournament_matches_scoring ON tournament_matches(scoring_match_id);
+
+CREATE TABLE IF NOT EXISTS tournament_standings (
+    tournament_id UUID NOT NULL REFERENCES tournaments(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES tournament_teams(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    rank INTEGER NOT NULL,
+    played INTEGER NOT NULL,
+    won INTEGER NOT NULL,
+    lost INTEGER NOT NULL,
+    points INTEGER NOT NULL,
+    PRIMARY KEY (tournament_id, team_id)
+);
+`
+
+// Sets and games of tournament matches, and the standings' totals and
+// tie-breaks they rank by
+const addTournamentTieBreaks = `
+ALTER TABLE tournament_matches ADD COLUMN IF NOT EXISTS sets_a INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE tournament_matches ADD COLUMN IF NOT EXISTS sets_b INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE tournament_matches ADD COLUMN IF NOT EXISTS games_a INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE tournament_matches ADD COLUMN IF NOT EXISTS games_b INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE tournament_standings ADD COLUMN IF NOT EXISTS sets_won INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE tournament_standings ADD COLUMN IF NOT EXISTS sets_lost INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE tournament_standings ADD COLUMN IF NOT EXISTS games_won INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE tournament_standings ADD COLUMN IF NOT EXISTS games_lost INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE tournament_standings ADD COLUMN IF NOT EXISTS tie_break VARCHAR(20) NOT NULL DEFAULT '';
+`
+
+// Short-format matches can be best of 5 games; earlier ones were best of 3
+const addMatchBestOf = `
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS best_of INTEGER NOT NULL DEFAULT 0;
+
+UPDATE matches SET best_of = 3 WHERE mode = 'short' AND best_of = 0;
+`
+
+// Voided points keep their sequence numbers: the points after them are not
+// renumbered, so the scoring device's numbering still lines up, and a retry
+// of a voided point is not stored again.
+const createVoidedPointsTable = `
+CREATE TABLE IF NOT EXISTS voided_points (
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    seq INTEGER NOT NULL,
+    event_id UUID NOT NULL,
+    voided_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (match_id, seq)
+);
+`
+
+// The seed random and balanced tournament teams were drawn with, so a draw
+// can be repeated
+const addTournamentTeamSeed = `
+ALTER TABLE tournaments ADD COLUMN IF NOT EXISTS team_seed BIGINT;
+`
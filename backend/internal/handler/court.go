@@ -0,0 +1,234 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+)
+
+// CourtHandler handles court endpoints.
+type CourtHandler struct {
+	repo      *repository.CourtRepository
+	venueRepo *repository.VenueRepository
+}
+
+// NewCourtHandler creates a new court handler.
+func NewCourtHandler(repo *repository.CourtRepository, venueRepo *repository.VenueRepository) *CourtHandler {
+	return &CourtHandler{repo: repo, venueRepo: venueRepo}
+}
+
+// CreateCourtRequest represents a create court request.
+// Surface is optional and defaults to the venue's surface.
+type CreateCourtRequest struct {
+	Name    string         `json:"name"`
+	Surface *model.Surface `json:"surface,omitempty"`
+	Indoor  bool           `json:"indoor"`
+	Lights  bool           `json:"lights"`
+}
+
+// UpdateCourtRequest represents an update court request.
+// An empty surface clears the override so the court uses the venue's surface.
+type UpdateCourtRequest struct {
+	Name    *string        `json:"name,omitempty"`
+	Surface *model.Surface `json:"surface,omitempty"`
+	Indoor  *bool          `json:"indoor,omitempty"`
+	Lights  *bool          `json:"lights,omitempty"`
+	Active  *bool          `json:"active,omitempty"`
+}
+
+// List returns the courts of a venue (admin: all, public: active only).
+// GET /api/venues/:id/courts
+func (h *CourtHandler) List(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	venueID := extractPathID(r.URL.Path, "venues")
+	if venueID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid venue id")
+		return
+	}
+
+	activeOnly := !strings.HasPrefix(r.URL.Path, "/api/admin")
+
+	courts, err := h.repo.ListByVenue(r.Context(), venueID, activeOnly)
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "failed to list courts")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, courts)
+}
+
+// Create adds a court to a venue.
+// POST /api/admin/venues/:id/courts
+func (h *CourtHandler) Create(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	venueID := extractPathID(r.URL.Path, "venues")
+	if venueID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid venue id")
+		return
+	}
+
+	var req CreateCourtRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	name, valid := ValidateNameWithLength(req.Name, 100)
+	if !valid {
+		WriteError(w, http.StatusBadRequest, "name is required and must be 100 characters or less without special characters")
+		return
+	}
+
+	if req.Surface != nil && !validSurfaces[*req.Surface] {
+		WriteError(w, http.StatusBadRequest, "surface must be hard, clay, or grass")
+		return
+	}
+
+	if _, err := h.venueRepo.GetByID(r.Context(), venueID); err != nil {
+		if err == repository.ErrNotFound {
+			WriteError(w, http.StatusNotFound, "venue not found")
+			return
+		}
+		WriteError(w, http.StatusInternalServerError, "failed to get venue")
+		return
+	}
+
+	court := &model.Court{
+		VenueID: venueID,
+		Name:    name,
+		Surface: req.Surface,
+		Indoor:  req.Indoor,
+		Lights:  req.Lights,
+		Active:  true,
+	}
+
+	if err := h.repo.Create(r.Context(), court); err != nil {
+		WriteError(w, http.StatusInternalServerError, "failed to create court")
+		return
+	}
+
+	WriteJSON(w, http.StatusCreated, court)
+}
+
+// Update modifies a court of a venue.
+// PATCH /api/admin/venues/:id/courts/:courtId
+func (h *CourtHandler) Update(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPatch {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req UpdateCourtRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	court, ok := h.courtFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	if req.Name != nil {
+		name, valid := ValidateNameWithLength(*req.Name, 100)
+		if !valid {
+			WriteError(w, http.StatusBadRequest, "name contains invalid characters or exceeds 100 characters")
+			return
+		}
+		court.Name = name
+	}
+	if req.Surface != nil {
+		switch {
+		case *req.Surface == "":
+			court.Surface = nil
+		case !validSurfaces[*req.Surface]:
+			WriteError(w, http.StatusBadRequest, "surface must be hard, clay, or grass")
+			return
+		default:
+			court.Surface = req.Surface
+		}
+	}
+	if req.Indoor != nil {
+		court.Indoor = *req.Indoor
+	}
+	if req.Lights != nil {
+		court.Lights = *req.Lights
+	}
+	if req.Active != nil {
+		court.Active = *req.Active
+	}
+
+	if err := h.repo.Update(r.Context(), court); err != nil {
+		WriteError(w, http.StatusInternalServerError, "failed to update court")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, court)
+}
+
+// Delete removes a court from a venue. Matches played on it keep their
+// venue; deactivate the court instead to keep it in tendency breakdowns.
+// DELETE /api/admin/venues/:id/courts/:courtId
+func (h *CourtHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	court, ok := h.courtFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.repo.Delete(r.Context(), court.ID); err != nil {
+		if err == repository.ErrNotFound {
+			WriteError(w, http.StatusNotFound, "court not found")
+			return
+		}
+		WriteError(w, http.StatusInternalServerError, "failed to delete court")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, map[string]string{
+		"message": "court deleted",
+	})
+}
+
+// courtFromPath loads the court named by a /venues/:id/courts/:courtId path,
+// writing an error and returning false if it is missing or belongs to
+// another venue.
+func (h *CourtHandler) courtFromPath(w http.ResponseWriter, r *http.Request) (*model.Court, bool) {
+	venueID := extractPathID(r.URL.Path, "venues")
+	courtID := extractPathID(r.URL.Path, "courts")
+	if venueID == uuid.Nil || courtID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid venue or court id")
+		return nil, false
+	}
+
+	court, err := h.repo.GetByID(r.Context(), courtID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			WriteError(w, http.StatusNotFound, "court not found")
+			return nil, false
+		}
+		WriteError(w, http.StatusInternalServerError, "failed to get court")
+		return nil, false
+	}
+	if court.VenueID != venueID {
+		WriteError(w, http.StatusNotFound, "court not found")
+		return nil, false
+	}
+
+	return court, true
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/service"
+)
+
+// exportTimeout bounds how long a single export may take to stream.
+const exportTimeout = 10 * time.Minute
+
+// Export handles GET /api/admin/export?format=csv|json|ndjson
+// Streams matches with their points and stats. Takes the same filters as
+// the match history.
+func (h *MatchHandler) Export(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	filter, ok := parseMatchFilter(w, r, nil)
+	if !ok {
+		return
+	}
+	h.writeExport(w, r, filter, "matches")
+}
+
+// PlayerExport handles GET /api/players/:id/export?format=csv|json|ndjson
+// Streams the matches a player took part in.
+func (h *MatchHandler) PlayerExport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	playerID := extractPathID(r.URL.Path, "players")
+	if playerID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid player id")
+		return
+	}
+
+	filter, ok := parseMatchFilter(w, r, &playerID)
+	if !ok {
+		return
+	}
+	h.writeExport(w, r, filter, "player-"+playerID.String())
+}
+
+// writeExport streams an export as an attachment. Once the first bytes are
+// sent the status can't change, so later failures are only logged.
+func (h *MatchHandler) writeExport(w http.ResponseWriter, r *http.Request, filter service.MatchHistoryFilter, name string) {
+	formatName := r.URL.Query().Get("format")
+	if formatName == "" {
+		formatName = string(service.ExportCSV)
+	}
+	format, err := service.ParseExportFormat(formatName)
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Large exports outlive the server's default write timeout
+	rc := http.NewResponseController(w)
+	_ = rc.SetWriteDeadline(time.Now().Add(exportTimeout))
+
+	filename := fmt.Sprintf("%s-%s.%s", name, time.Now().UTC().Format("20060102"), format)
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filename))
+	w.WriteHeader(http.StatusOK)
+
+	if err := h.svc.ExportMatches(r.Context(), filter, format, w); err != nil {
+		log.Printf("export %s: %v", name, err)
+	}
+}
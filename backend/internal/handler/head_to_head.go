@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/service"
+)
+
+// HeadToHead handles GET /api/head-to-head?a=<player|team>&b=<player|team>
+// A side is a player ID or a doubles pair as "id1:id2". Optional filters:
+// venue_id, surface, from, to.
+func (h *MatchHandler) HeadToHead(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	query := r.URL.Query()
+	a, b := query.Get("a"), query.Get("b")
+	if a == "" || b == "" {
+		WriteError(w, http.StatusBadRequest, "a and b are required")
+		return
+	}
+
+	var filter service.HeadToHeadFilter
+	if value := query.Get("venue_id"); value != "" {
+		venueID, err := uuid.Parse(value)
+		if err != nil {
+			WriteError(w, http.StatusBadRequest, "invalid venue_id")
+			return
+		}
+		filter.VenueID = &venueID
+	}
+	if value := query.Get("surface"); value != "" {
+		surface := model.Surface(value)
+		if !validSurfaces[surface] {
+			WriteError(w, http.StatusBadRequest, "surface must be hard, clay, or grass")
+			return
+		}
+		filter.Surface = &surface
+	}
+	var ok bool
+	if filter.From, filter.To, ok = parseTimeRange(w, query); !ok {
+		return
+	}
+
+	result, err := h.svc.GetHeadToHead(r.Context(), a, b, filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidSide):
+			WriteError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, repository.ErrNotFound):
+			WriteError(w, http.StatusNotFound, "player not found")
+		default:
+			WriteError(w, http.StatusInternalServerError, "failed to get head-to-head")
+		}
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, result)
+}
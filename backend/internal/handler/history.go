@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/service"
+)
+
+const (
+	defaultHistoryLimit = 50
+	maxHistoryLimit     = 200
+)
+
+// validMatchStatuses is a set of valid match statuses.
+var validMatchStatuses = map[model.MatchStatus]bool{
+	model.MatchStatusScheduled:  true,
+	model.MatchStatusInProgress: true,
+	model.MatchStatusSuspended:  true,
+	model.MatchStatusCompleted:  true,
+	model.MatchStatusAbandoned:  true,
+}
+
+// List handles GET /api/matches
+// Returns the match history newest first, with the stored result of each
+// match. Filters: venue_id, player_id, partner_id, opponent_id, match_type,
+// mode, status, from, to (YYYY-MM-DD, inclusive, or RFC 3339).
+// Pagination: limit and the next_cursor of the previous page as cursor.
+func (h *MatchHandler) List(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	filter, ok := parseMatchFilter(w, r, nil)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	limit := defaultHistoryLimit
+	if value := query.Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > maxHistoryLimit {
+			WriteError(w, http.StatusBadRequest, fmt.Sprintf("limit must be between 1 and %d", maxHistoryLimit))
+			return
+		}
+		limit = parsed
+	}
+
+	history, err := h.svc.ListMatches(r.Context(), filter, query.Get("cursor"), limit)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidCursor) {
+			WriteError(w, http.StatusBadRequest, "invalid cursor")
+			return
+		}
+		WriteError(w, http.StatusInternalServerError, "failed to list matches")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, history)
+}
+
+// parseMatchFilter reads the match filter parameters shared by the history
+// and export endpoints. A non-nil player replaces the player_id parameter.
+// Writes a 400 and returns false if any parameter is invalid.
+func parseMatchFilter(w http.ResponseWriter, r *http.Request, player *uuid.UUID) (service.MatchHistoryFilter, bool) {
+	query := r.URL.Query()
+	var filter service.MatchHistoryFilter
+
+	ids := []struct {
+		param string
+		dest  **uuid.UUID
+	}{
+		{"venue_id", &filter.VenueID},
+		{"player_id", &filter.PlayerID},
+		{"partner_id", &filter.PartnerID},
+		{"opponent_id", &filter.OpponentID},
+	}
+	for _, id := range ids {
+		value := query.Get(id.param)
+		if value == "" {
+			continue
+		}
+		parsed, err := uuid.Parse(value)
+		if err != nil {
+			WriteError(w, http.StatusBadRequest, "invalid "+id.param)
+			return filter, false
+		}
+		*id.dest = &parsed
+	}
+	if player != nil {
+		filter.PlayerID = player
+	}
+	if filter.PlayerID == nil && (filter.PartnerID != nil || filter.OpponentID != nil) {
+		WriteError(w, http.StatusBadRequest, "partner_id and opponent_id require player_id")
+		return filter, false
+	}
+
+	if value := query.Get("match_type"); value != "" {
+		filter.MatchType = model.MatchType(value)
+		if !validMatchTypes[filter.MatchType] {
+			WriteError(w, http.StatusBadRequest, "match_type must be singles, doubles, or 1v2")
+			return filter, false
+		}
+	}
+	if value := query.Get("mode"); value != "" {
+		filter.Mode = model.MatchMode(value)
+		if !validMatchModes[filter.Mode] {
+			WriteError(w, http.StatusBadRequest, "mode must be standard or short")
+			return filter, false
+		}
+	}
+	if value := query.Get("status"); value != "" {
+		filter.Status = model.MatchStatus(value)
+		if !validMatchStatuses[filter.Status] {
+			WriteError(w, http.StatusBadRequest, "invalid status")
+			return filter, false
+		}
+	}
+	if value := query.Get("surface"); value != "" {
+		surface := model.Surface(value)
+		if !validSurfaces[surface] {
+			WriteError(w, http.StatusBadRequest, "surface must be hard, clay, or grass")
+			return filter, false
+		}
+		filter.Surface = &surface
+	}
+
+	var ok bool
+	if filter.From, filter.To, ok = parseTimeRange(w, query); !ok {
+		return filter, false
+	}
+
+	return filter, true
+}
+
+// parseTimeRange reads the optional from/to query parameters, writing an
+// error response if either is invalid.
+func parseTimeRange(w http.ResponseWriter, query url.Values) (from, to *time.Time, ok bool) {
+	if value := query.Get("from"); value != "" {
+		t, ok := parseHistoryTime(value, false)
+		if !ok {
+			WriteError(w, http.StatusBadRequest, "from must be YYYY-MM-DD or RFC 3339")
+			return nil, nil, false
+		}
+		from = &t
+	}
+	if value := query.Get("to"); value != "" {
+		t, ok := parseHistoryTime(value, true)
+		if !ok {
+			WriteError(w, http.StatusBadRequest, "to must be YYYY-MM-DD or RFC 3339")
+			return nil, nil, false
+		}
+		to = &t
+	}
+	return from, to, true
+}
+
+// parseHistoryTime parses a from/to bound. A bare date is midnight UTC; as
+// an upper bound it covers the whole day.
+func parseHistoryTime(value string, end bool) (time.Time, bool) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, true
+	}
+	day, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	if end {
+		day = day.AddDate(0, 0, 1)
+	}
+	return day, true
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// maxImportBody bounds the size of an import upload.
+const maxImportBody = 32 << 20
+
+// Import handles POST /api/admin/import?dry_run=true
+// Body is NDJSON, one match per line (see service.ImportLine). Every line
+// is validated first; if any line fails nothing is imported and the errors
+// are returned with 422.
+func (h *MatchHandler) Import(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	dryRun := false
+	if v := r.URL.Query().Get("dry_run"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			WriteError(w, http.StatusBadRequest, "invalid dry_run")
+			return
+		}
+		dryRun = b
+	}
+
+	body := http.MaxBytesReader(w, r.Body, maxImportBody)
+	result, err := h.svc.ImportMatches(r.Context(), body, dryRun)
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "failed to import matches")
+		return
+	}
+
+	status := http.StatusOK
+	switch {
+	case len(result.Errors) > 0:
+		status = http.StatusUnprocessableEntity
+	case !dryRun:
+		status = http.StatusCreated
+	}
+	WriteJSON(w, status, result)
+}
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/service"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/tournament"
+)
+
+// SuspendMatchRequest is the optional body of a suspend request.
+type SuspendMatchRequest struct {
+	Reason string `json:"reason"`
+}
+
+// Start handles POST /api/matches/:id/start
+// Starts a scheduled match so the scoring device can record points.
+func (h *MatchHandler) Start(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	matchID := extractMatchIDFromPath(r.URL.Path)
+	if matchID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	match, err := h.svc.StartMatch(r.Context(), matchID)
+	h.writeTransition(w, match, err)
+}
+
+// Suspend handles POST /api/matches/:id/suspend
+func (h *MatchHandler) Suspend(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	matchID := extractMatchIDFromPath(r.URL.Path)
+	if matchID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	var req SuspendMatchRequest
+	if r.ContentLength != 0 {
+		if err := DecodeJSON(r, &req); err != nil {
+			WriteError(w, http.StatusBadRequest, "invalid request body")
+			return
+		}
+	}
+
+	if len(req.Reason) > 200 {
+		WriteError(w, http.StatusBadRequest, "reason must be 200 characters or less")
+		return
+	}
+
+	match, err := h.svc.SuspendMatch(r.Context(), matchID, req.Reason)
+	h.writeTransition(w, match, err)
+}
+
+// Resume handles POST /api/matches/:id/resume
+func (h *MatchHandler) Resume(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	matchID := extractMatchIDFromPath(r.URL.Path)
+	if matchID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	match, err := h.svc.ResumeMatch(r.Context(), matchID)
+	h.writeTransition(w, match, err)
+}
+
+// Abandon handles POST /api/matches/:id/abandon
+func (h *MatchHandler) Abandon(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	matchID := extractMatchIDFromPath(r.URL.Path)
+	if matchID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	match, err := h.svc.AbandonMatch(r.Context(), matchID)
+	h.writeTransition(w, match, err)
+}
+
+// Void handles POST /api/matches/:id/void
+func (h *MatchHandler) Void(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	matchID := extractMatchIDFromPath(r.URL.Path)
+	if matchID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	match, err := h.svc.VoidMatch(r.Context(), matchID)
+	h.writeTransition(w, match, err)
+}
+
+// writeTransition writes the result of a match status change.
+func (h *MatchHandler) writeTransition(w http.ResponseWriter, match *model.Match, err error) {
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			WriteError(w, http.StatusNotFound, "match not found")
+		case errors.Is(err, service.ErrInvalidTransition), errors.Is(err, repository.ErrStatusChanged),
+			errors.Is(err, tournament.ErrResultLocked), errors.Is(err, repository.ErrVersionConflict):
+			WriteError(w, http.StatusConflict, err.Error())
+		default:
+			WriteError(w, http.StatusInternalServerError, "failed to update match status")
+		}
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, match)
+}
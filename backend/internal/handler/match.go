@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
 	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
 	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/service"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/tournament"
 )
 
 // MatchHandler handles match endpoints.
@@ -25,12 +27,54 @@ func NewMatchHandler(svc *service.MatchService, matchRepo *repository.MatchRepos
 // EventRequest represents a point event in a request.
 type EventRequest struct {
 	ID              uuid.UUID       `json:"id"`
+	Seq             int             `json:"seq,omitempty"`
 	Timestamp       string          `json:"timestamp"`
 	ServerPlayerID  uuid.UUID       `json:"server_player_id"`
 	ServeType       model.ServeType `json:"serve_type"`
 	PointWinnerTeam model.Team      `json:"point_winner_team"`
 }
 
+// toPointEvent validates the request and converts it to a point event.
+// Returns a client-facing message if the event is invalid.
+func (e EventRequest) toPointEvent(matchID uuid.UUID) (model.PointEvent, string) {
+	if e.ID == uuid.Nil {
+		return model.PointEvent{}, "event id is required"
+	}
+
+	// A missing seq (0) is numbered after the last point
+	if e.Seq < 0 {
+		return model.PointEvent{}, "seq must not be negative"
+	}
+
+	if e.ServerPlayerID == uuid.Nil {
+		return model.PointEvent{}, "server_player_id is required"
+	}
+
+	if !validServeTypes[e.ServeType] {
+		return model.PointEvent{}, "serve_type must be first, second, or double_fault"
+	}
+
+	if !validTeams[e.PointWinnerTeam] {
+		return model.PointEvent{}, "point_winner_team must be A or B"
+	}
+
+	// Parse timestamp
+	timestamp, err := parseTimestamp(e.Timestamp)
+	if err != nil {
+		return model.PointEvent{}, "invalid timestamp format"
+	}
+
+	return model.PointEvent{
+		ID:              e.ID,
+		MatchID:         matchID,
+		Seq:             e.Seq,
+		Timestamp:       timestamp,
+		ServerPlayerID:  e.ServerPlayerID,
+		ServeType:       e.ServeType,
+		PointWinnerTeam: e.PointWinnerTeam,
+	}, ""
+}
+
 // EventsRequest represents a batch of events.
 type EventsRequest struct {
 	Events []EventRequest `json:"events"`
@@ -56,6 +100,12 @@ var validMatchTypes = map[model.MatchType]bool{
 	model.MatchTypeAustralianDoubles: true,
 }
 
+// validMatchModes is a set of valid scoring modes.
+var validMatchModes = map[model.MatchMode]bool{
+	model.MatchModeStandard: true,
+	model.MatchModeShort:    true,
+}
+
 // Create starts a new match.
 func (h *MatchHandler) Create(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -80,6 +130,11 @@ func (h *MatchHandler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if req.Mode != "" && !validMatchModes[req.Mode] {
+		WriteError(w, http.StatusBadRequest, "mode must be standard or short")
+		return
+	}
+
 	if len(req.TeamA) == 0 {
 		WriteError(w, http.StatusBadRequest, "team_a is required")
 		return
@@ -90,9 +145,18 @@ func (h *MatchHandler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if req.ScheduledAt != nil && req.ScheduledAt.IsZero() {
+		WriteError(w, http.StatusBadRequest, "invalid scheduled_at")
+		return
+	}
+
 	match, err := h.svc.CreateMatch(r.Context(), req)
 	if err != nil {
-		WriteError(w, http.StatusBadRequest, err.Error())
+		if errors.Is(err, service.ErrInvalidMatch) {
+			WriteError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		WriteError(w, http.StatusInternalServerError, "failed to create match")
 		return
 	}
 
@@ -132,52 +196,58 @@ func (h *MatchHandler) AddEvents(w http.ResponseWriter, r *http.Request) {
 	// Convert and validate events
 	events := make([]model.PointEvent, len(req.Events))
 	for i, e := range req.Events {
-		if e.ID == uuid.Nil {
-			WriteError(w, http.StatusBadRequest, "event id is required")
+		event, msg := e.toPointEvent(matchID)
+		if msg != "" {
+			WriteError(w, http.StatusBadRequest, msg)
 			return
 		}
+		events[i] = event
+	}
 
-		if e.ServerPlayerID == uuid.Nil {
-			WriteError(w, http.StatusBadRequest, "server_player_id is required")
+	result, err := h.svc.AddEvents(r.Context(), matchID, events)
+	if err != nil {
+		if errors.Is(err, repository.ErrSequenceTaken) {
+			WriteError(w, http.StatusConflict, err.Error())
 			return
 		}
+		WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
-		if !validServeTypes[e.ServeType] {
-			WriteError(w, http.StatusBadRequest, "serve_type must be first, second, or double_fault")
-			return
-		}
+	WriteJSON(w, http.StatusOK, result)
+}
 
-		if !validTeams[e.PointWinnerTeam] {
-			WriteError(w, http.StatusBadRequest, "point_winner_team must be A or B")
-			return
-		}
+// VoidEvent removes a recorded point (e.g. scored by mistake).
+func (h *MatchHandler) VoidEvent(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
 
-		// Parse timestamp
-		timestamp, err := parseTimestamp(e.Timestamp)
-		if err != nil {
-			WriteError(w, http.StatusBadRequest, "invalid timestamp format")
-			return
-		}
+	// Extract IDs from path: /api/matches/:id/events/:eventId
+	matchID := extractMatchIDFromPath(r.URL.Path)
+	if matchID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
 
-		events[i] = model.PointEvent{
-			ID:              e.ID,
-			MatchID:         matchID,
-			Timestamp:       timestamp,
-			ServerPlayerID:  e.ServerPlayerID,
-			ServeType:       e.ServeType,
-			PointWinnerTeam: e.PointWinnerTeam,
-		}
+	eventID := extractIDFromPath(r.URL.Path)
+	if eventID == uuid.Nil || eventID == matchID {
+		WriteError(w, http.StatusBadRequest, "invalid event id")
+		return
 	}
 
-	inserted, err := h.svc.AddEvents(r.Context(), matchID, events)
-	if err != nil {
+	if err := h.svc.VoidEvent(r.Context(), matchID, eventID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			WriteError(w, http.StatusNotFound, err.Error())
+			return
+		}
 		WriteError(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	WriteJSON(w, http.StatusOK, map[string]interface{}{
-		"inserted": inserted,
-		"total":    len(events),
+	WriteJSON(w, http.StatusOK, map[string]string{
+		"message": "point voided",
 	})
 }
 
@@ -196,11 +266,15 @@ func (h *MatchHandler) Complete(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.svc.CompleteMatch(r.Context(), matchID); err != nil {
-		if err == repository.ErrNotFound {
+		switch {
+		case errors.Is(err, repository.ErrNotFound), errors.Is(err, service.ErrAlreadyInStatus):
 			WriteError(w, http.StatusNotFound, "match not found or already completed")
-			return
+		case errors.Is(err, service.ErrInvalidTransition), errors.Is(err, repository.ErrStatusChanged),
+			errors.Is(err, repository.ErrVersionConflict):
+			WriteError(w, http.StatusConflict, err.Error())
+		default:
+			WriteError(w, http.StatusInternalServerError, "failed to complete match")
 		}
-		WriteError(w, http.StatusInternalServerError, "failed to complete match")
 		return
 	}
 
@@ -232,6 +306,33 @@ func (h *MatchHandler) Summary(w http.ResponseWriter, r *http.Request) {
 	WriteJSON(w, http.StatusOK, summary)
 }
 
+// State returns the live score of a match, replayed from its stored events.
+func (h *MatchHandler) State(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// Extract match ID from path: /api/matches/:id/state
+	matchID := extractMatchIDFromPath(r.URL.Path)
+	if matchID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	state, err := h.svc.GetLiveState(r.Context(), matchID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			WriteError(w, http.StatusNotFound, "match not found")
+			return
+		}
+		WriteError(w, http.StatusInternalServerError, "failed to get match state")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, state)
+}
+
 // Delete removes a match (admin only).
 func (h *MatchHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodDelete {
@@ -250,6 +351,10 @@ func (h *MatchHandler) Delete(w http.ResponseWriter, r *http.Request) {
 			WriteError(w, http.StatusNotFound, "match not found")
 			return
 		}
+		if errors.Is(err, tournament.ErrResultLocked) || errors.Is(err, repository.ErrVersionConflict) {
+			WriteError(w, http.StatusConflict, err.Error())
+			return
+		}
 		WriteError(w, http.StatusInternalServerError, "failed to delete match")
 		return
 	}
@@ -259,22 +364,6 @@ func (h *MatchHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
-// List returns recent matches.
-func (h *MatchHandler) List(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
-		return
-	}
-
-	matches, err := h.matchRepo.List(r.Context(), 50)
-	if err != nil {
-		WriteError(w, http.StatusInternalServerError, "failed to list matches")
-		return
-	}
-
-	WriteJSON(w, http.StatusOK, matches)
-}
-
 // extractMatchIDFromPath extracts UUID from paths like /api/matches/:id/events
 func extractMatchIDFromPath(path string) uuid.UUID {
 	parts := strings.Split(path, "/")
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+)
+
+// Pace handles GET /api/matches/:id/pace
+// Reports playing time, time between points and the duration of each set
+// and the longest game.
+func (h *MatchHandler) Pace(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// Extract match ID from path: /api/matches/:id/pace
+	matchID := extractMatchIDFromPath(r.URL.Path)
+	if matchID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	pace, err := h.svc.GetMatchPace(r.Context(), matchID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			WriteError(w, http.StatusNotFound, "match not found")
+			return
+		}
+		WriteError(w, http.StatusInternalServerError, "failed to get match pace")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, pace)
+}
+
+// VenuePace handles GET /api/venues/:id/pace?from=2024-01-01&to=2024-12-31
+// Aggregates how long completed matches at a venue take, by scoring mode.
+func (h *MatchHandler) VenuePace(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	venueID := extractVenueIDFromPath(r.URL.Path)
+	if venueID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid venue id")
+		return
+	}
+
+	from, to, ok := parseTimeRange(w, r.URL.Query())
+	if !ok {
+		return
+	}
+
+	pace, err := h.svc.GetVenuePace(r.Context(), venueID, from, to)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			WriteError(w, http.StatusNotFound, "venue not found")
+			return
+		}
+		WriteError(w, http.StatusInternalServerError, "failed to get venue pace")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, pace)
+}
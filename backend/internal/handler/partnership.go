@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+)
+
+// Partnerships handles GET /api/partnerships?min_matches=3
+// Returns doubles pairs across all venues with their chemistry and team
+// rating, best chemistry first.
+func (h *RatingHandler) Partnerships(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	minMatches, ok := parseMinMatches(w, r)
+	if !ok {
+		return
+	}
+
+	partnerships, err := h.svc.GetPartnerships(r.Context(), minMatches)
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "failed to get partnerships")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, partnerships)
+}
+
+// PlayerPartners handles GET /api/players/:id/partners?min_matches=3
+// Returns the player's doubles partners, the ones they win with most first.
+func (h *RatingHandler) PlayerPartners(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	playerID := extractPathID(r.URL.Path, "players")
+	if playerID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid player id")
+		return
+	}
+
+	minMatches, ok := parseMinMatches(w, r)
+	if !ok {
+		return
+	}
+
+	partners, err := h.svc.GetPlayerPartners(r.Context(), playerID, minMatches)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			WriteError(w, http.StatusNotFound, "player not found")
+			return
+		}
+		WriteError(w, http.StatusInternalServerError, "failed to get partners")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, partners)
+}
+
+// parseMinMatches reads the min_matches parameter, defaulting to the venue
+// tendencies team threshold. Writes a 400 and returns false if invalid.
+func parseMinMatches(w http.ResponseWriter, r *http.Request) (int, bool) {
+	value := r.URL.Query().Get("min_matches")
+	if value == "" {
+		return model.MinTeamMatchesForTendency, true
+	}
+	minMatches, err := strconv.Atoi(value)
+	if err != nil || minMatches < 1 {
+		WriteError(w, http.StatusBadRequest, "min_matches must be a positive number")
+		return 0, false
+	}
+	return minMatches, true
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+)
+
+// PlayerProfile handles GET /api/players/:id/profile
+// Aggregates a player's completed matches: records by match type and
+// surface, partners, opponents, serve trends and recent form.
+func (h *MatchHandler) PlayerProfile(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	playerID := extractPathID(r.URL.Path, "players")
+	if playerID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid player id")
+		return
+	}
+
+	profile, err := h.svc.GetPlayerProfile(r.Context(), playerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			WriteError(w, http.StatusNotFound, "player not found")
+			return
+		}
+		WriteError(w, http.StatusInternalServerError, "failed to get player profile")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, profile)
+}
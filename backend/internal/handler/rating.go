@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/service"
+)
+
+var validRatingKinds = map[model.RatingKind]bool{
+	model.RatingKindSingles: true,
+	model.RatingKindDoubles: true,
+}
+
+// RatingHandler handles player rating endpoints.
+type RatingHandler struct {
+	svc *service.RatingService
+}
+
+// NewRatingHandler creates a new rating handler.
+func NewRatingHandler(svc *service.RatingService) *RatingHandler {
+	return &RatingHandler{svc: svc}
+}
+
+// List handles GET /api/ratings?kind=singles|doubles
+// Returns the ratings table of active players, highest first. The kind
+// defaults to singles.
+func (h *RatingHandler) List(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	kind := model.RatingKindSingles
+	if value := r.URL.Query().Get("kind"); value != "" {
+		kind = model.RatingKind(value)
+		if !validRatingKinds[kind] {
+			WriteError(w, http.StatusBadRequest, "kind must be singles or doubles")
+			return
+		}
+	}
+
+	ratings, err := h.svc.ListRatings(r.Context(), kind)
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "failed to list ratings")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, ratings)
+}
+
+// History handles GET /api/players/:id/rating-history?kind=singles|doubles
+// Returns the player's current ratings and the change made by each rated
+// match, oldest first. Both kinds are returned unless kind is given.
+func (h *RatingHandler) History(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	playerID := extractPathID(r.URL.Path, "players")
+	if playerID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid player id")
+		return
+	}
+
+	kind := model.RatingKind(r.URL.Query().Get("kind"))
+	if kind != "" && !validRatingKinds[kind] {
+		WriteError(w, http.StatusBadRequest, "kind must be singles or doubles")
+		return
+	}
+
+	history, err := h.svc.GetRatingHistory(r.Context(), playerID, kind)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			WriteError(w, http.StatusNotFound, "player not found")
+			return
+		}
+		WriteError(w, http.StatusInternalServerError, "failed to get rating history")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, history)
+}
+
+// Recompute handles POST /api/admin/ratings/recompute
+// Rebuilds every rating from the completed matches, for use after old
+// matches were edited.
+func (h *RatingHandler) Recompute(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	if err := h.svc.Recompute(r.Context()); err != nil {
+		WriteError(w, http.StatusInternalServerError, "failed to recompute ratings")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, map[string]string{
+		"message": "ratings recomputed",
+	})
+}
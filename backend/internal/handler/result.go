@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/service"
+)
+
+// RecordResult handles POST /api/matches/results
+// Records a completed match by its final score, without points.
+func (h *MatchHandler) RecordResult(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req service.RecordResultRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.VenueID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "venue_id is required")
+		return
+	}
+
+	if !validMatchTypes[req.MatchType] {
+		WriteError(w, http.StatusBadRequest, "match_type must be singles, doubles, or 1v2")
+		return
+	}
+
+	if req.Mode != "" && !validMatchModes[req.Mode] {
+		WriteError(w, http.StatusBadRequest, "mode must be standard or short")
+		return
+	}
+
+	if len(req.TeamA) == 0 || len(req.TeamB) == 0 {
+		WriteError(w, http.StatusBadRequest, "team_a and team_b are required")
+		return
+	}
+
+	if req.Score == "" {
+		WriteError(w, http.StatusBadRequest, "score is required")
+		return
+	}
+
+	if req.PlayedAt != nil && req.PlayedAt.IsZero() {
+		WriteError(w, http.StatusBadRequest, "invalid played_at")
+		return
+	}
+
+	match, err := h.svc.RecordResult(r.Context(), req)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidMatch) {
+			WriteError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		WriteError(w, http.StatusInternalServerError, "failed to record result")
+		return
+	}
+
+	WriteJSON(w, http.StatusCreated, match)
+}
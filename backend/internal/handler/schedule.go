@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+)
+
+// Schedule handles GET /api/schedule?date=2024-06-01&venue_id=...&tz=Asia/Kolkata
+// Lists the matches planned or in play on a day and any double-bookings.
+// date defaults to today and tz to UTC.
+func (h *MatchHandler) Schedule(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	query := r.URL.Query()
+
+	loc := time.UTC
+	if tz := query.Get("tz"); tz != "" {
+		parsed, err := time.LoadLocation(tz)
+		if err != nil {
+			WriteError(w, http.StatusBadRequest, "invalid tz")
+			return
+		}
+		loc = parsed
+	}
+
+	now := time.Now().In(loc)
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	if value := query.Get("date"); value != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", value, loc)
+		if err != nil {
+			WriteError(w, http.StatusBadRequest, "date must be YYYY-MM-DD")
+			return
+		}
+		day = parsed
+	}
+
+	var venueID *uuid.UUID
+	if value := query.Get("venue_id"); value != "" {
+		parsed, err := uuid.Parse(value)
+		if err != nil {
+			WriteError(w, http.StatusBadRequest, "invalid venue_id")
+			return
+		}
+		venueID = &parsed
+	}
+
+	schedule, err := h.svc.GetSchedule(r.Context(), day, venueID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			WriteError(w, http.StatusNotFound, "venue not found")
+			return
+		}
+		WriteError(w, http.StatusInternalServerError, "failed to get schedule")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, schedule)
+}
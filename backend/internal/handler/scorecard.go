@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/service"
+)
+
+// Scorecard handles GET /api/matches/:id/scorecard?format=html|svg&print=true&tz=Asia/Kolkata
+// Renders a printable scorecard. format defaults to html and tz to UTC.
+func (h *MatchHandler) Scorecard(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// Extract match ID from path: /api/matches/:id/scorecard
+	matchID := extractMatchIDFromPath(r.URL.Path)
+	if matchID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	query := r.URL.Query()
+	opts := service.ScorecardOptions{Format: service.ScorecardHTML, Location: time.UTC}
+
+	if value := query.Get("format"); value != "" {
+		format, err := service.ParseScorecardFormat(value)
+		if err != nil {
+			WriteError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		opts.Format = format
+	}
+
+	if value := query.Get("print"); value != "" {
+		printable, err := strconv.ParseBool(value)
+		if err != nil {
+			WriteError(w, http.StatusBadRequest, "invalid print")
+			return
+		}
+		opts.Print = printable
+	}
+
+	if tz := query.Get("tz"); tz != "" {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			WriteError(w, http.StatusBadRequest, "invalid tz")
+			return
+		}
+		opts.Location = loc
+	}
+
+	card, err := h.svc.Scorecard(r.Context(), matchID, opts)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			WriteError(w, http.StatusNotFound, "match not found")
+			return
+		}
+		WriteError(w, http.StatusInternalServerError, "failed to render scorecard")
+		return
+	}
+
+	w.Header().Set("Content-Type", opts.Format.ContentType())
+	w.WriteHeader(http.StatusOK)
+	w.Write(card)
+}
@@ -0,0 +1,220 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/service"
+)
+
+// sessionPollTimeout is how long a session poll waits for a change.
+const sessionPollTimeout = 25 * time.Second
+
+// SessionHandler handles multi-device scoring session endpoints.
+type SessionHandler struct {
+	svc *service.SessionService
+}
+
+// NewSessionHandler creates a new session handler.
+func NewSessionHandler(svc *service.SessionService) *SessionHandler {
+	return &SessionHandler{svc: svc}
+}
+
+// SessionPointRequest is a point submitted by a scoring device.
+type SessionPointRequest struct {
+	ClientSeq int `json:"client_seq"`
+	EventRequest
+}
+
+// SubmitPointsRequest is a batch of points from one device.
+type SubmitPointsRequest struct {
+	DeviceID string `json:"device_id"`
+
+	// BasePoints is how many points the device had seen before these
+	BasePoints int                   `json:"base_points"`
+	Points     []SessionPointRequest `json:"points"`
+}
+
+// ResolveConflictRequest settles a scoring conflict.
+type ResolveConflictRequest struct {
+	Resolution model.ConflictResolution `json:"resolution"`
+}
+
+// Poll handles GET /api/matches/:id/session?cursor=N
+// Long-polls until the match changes after cursor, then returns the agreed
+// state, open conflicts and participating devices.
+func (h *SessionHandler) Poll(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	matchID := extractMatchIDFromPath(r.URL.Path)
+	if matchID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	var cursor uint64
+	if value := r.URL.Query().Get("cursor"); value != "" {
+		parsed, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			WriteError(w, http.StatusBadRequest, "invalid cursor")
+			return
+		}
+		cursor = parsed
+	}
+
+	// Polls outlive the server's default write timeout
+	rc := http.NewResponseController(w)
+	_ = rc.SetWriteDeadline(time.Now().Add(sessionPollTimeout + 10*time.Second))
+
+	session, err := h.svc.Poll(r.Context(), matchID, cursor, sessionPollTimeout)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			WriteError(w, http.StatusNotFound, "match not found")
+			return
+		}
+		if r.Context().Err() != nil {
+			return
+		}
+		WriteError(w, http.StatusInternalServerError, "failed to get session")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, session)
+}
+
+// Submit handles POST /api/matches/:id/session/points
+func (h *SessionHandler) Submit(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	matchID := extractMatchIDFromPath(r.URL.Path)
+	if matchID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	var req SubmitPointsRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.DeviceID == "" || len(req.DeviceID) > 100 {
+		WriteError(w, http.StatusBadRequest, "device_id is required (max 100 characters)")
+		return
+	}
+
+	if len(req.Points) == 0 {
+		WriteError(w, http.StatusBadRequest, "points array is required")
+		return
+	}
+
+	if len(req.Points) > 1000 {
+		WriteError(w, http.StatusBadRequest, "maximum 1000 points per request")
+		return
+	}
+
+	points := make([]service.SessionPoint, len(req.Points))
+	for i, p := range req.Points {
+		if p.ClientSeq <= 0 {
+			WriteError(w, http.StatusBadRequest, "client_seq must be positive")
+			return
+		}
+
+		event, msg := p.EventRequest.toPointEvent(matchID)
+		if msg != "" {
+			WriteError(w, http.StatusBadRequest, msg)
+			return
+		}
+
+		points[i] = service.SessionPoint{ClientSeq: p.ClientSeq, Event: event}
+	}
+
+	result, err := h.svc.Submit(r.Context(), matchID, req.DeviceID, req.BasePoints, points)
+	if err != nil {
+		if errors.Is(err, service.ErrSessionAhead) || errors.Is(err, repository.ErrSequenceTaken) {
+			WriteError(w, http.StatusConflict, err.Error())
+			return
+		}
+		WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	status := http.StatusOK
+	if result.Conflict != nil {
+		status = http.StatusConflict
+	}
+	WriteJSON(w, status, result)
+}
+
+// Resolve handles POST /api/matches/:id/session/conflicts/:conflictId/resolve
+func (h *SessionHandler) Resolve(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	matchID := extractMatchIDFromPath(r.URL.Path)
+	if matchID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	conflictID := extractPathID(r.URL.Path, "conflicts")
+	if conflictID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid conflict id")
+		return
+	}
+
+	var req ResolveConflictRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.Resolution != model.ResolutionKeepCanonical && req.Resolution != model.ResolutionAcceptProposed {
+		WriteError(w, http.StatusBadRequest, "resolution must be keep_canonical or accept_proposed")
+		return
+	}
+
+	session, err := h.svc.Resolve(r.Context(), matchID, conflictID, req.Resolution)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			WriteError(w, http.StatusNotFound, "conflict not found")
+		case errors.Is(err, service.ErrConflictResolved):
+			WriteError(w, http.StatusConflict, err.Error())
+		default:
+			WriteError(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, session)
+}
+
+// extractPathID extracts the UUID following a named segment, e.g.
+// extractPathID("/api/matches/1/session/conflicts/2/resolve", "conflicts").
+func extractPathID(path, segment string) uuid.UUID {
+	parts := strings.Split(path, "/")
+	for i, part := range parts {
+		if part == segment && i+1 < len(parts) {
+			id, err := uuid.Parse(parts[i+1])
+			if err == nil {
+				return id
+			}
+		}
+	}
+	return uuid.Nil
+}
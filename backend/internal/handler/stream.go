@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/service"
+)
+
+// streamHeartbeatInterval keeps idle event streams open through proxies.
+const streamHeartbeatInterval = 15 * time.Second
+
+// Stream handles GET /api/matches/:id/stream
+// Pushes a Server-Sent Event whenever a point is added or voided, or the
+// match completes.
+func (h *MatchHandler) Stream(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// Extract match ID from path: /api/matches/:id/stream
+	matchID := extractMatchIDFromPath(r.URL.Path)
+	if matchID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	sub, initial, err := h.svc.SubscribeMatch(r.Context(), matchID, lastEventID(r))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			WriteError(w, http.StatusNotFound, "match not found")
+			return
+		}
+		WriteError(w, http.StatusInternalServerError, "failed to subscribe to match")
+		return
+	}
+	defer h.svc.Unsubscribe(sub)
+
+	serveEventStream(w, r, sub, initial)
+}
+
+// VenueStream handles GET /api/venues/:id/stream
+// Multiplexes live updates for all in-progress matches at a venue.
+func (h *MatchHandler) VenueStream(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// Extract venue ID from path: /api/venues/:id/stream
+	venueID := extractVenueIDFromPath(r.URL.Path)
+	if venueID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid venue id")
+		return
+	}
+
+	sub, initial, err := h.svc.SubscribeVenue(r.Context(), venueID, lastEventID(r))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			WriteError(w, http.StatusNotFound, "venue not found")
+			return
+		}
+		WriteError(w, http.StatusInternalServerError, "failed to subscribe to venue")
+		return
+	}
+	defer h.svc.Unsubscribe(sub)
+
+	serveEventStream(w, r, sub, initial)
+}
+
+// serveEventStream writes updates as Server-Sent Events until the client
+// disconnects or the subscription is dropped.
+func serveEventStream(w http.ResponseWriter, r *http.Request, sub *service.LiveSubscription, initial []model.LiveUpdate) {
+	rc := http.NewResponseController(w)
+
+	// Streams outlive the server's write timeout
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no") // Disable nginx buffering
+	w.WriteHeader(http.StatusOK)
+
+	for _, update := range initial {
+		if err := writeEvent(w, update); err != nil {
+			return
+		}
+	}
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+
+		case update, ok := <-sub.C:
+			if !ok {
+				// Dropped for falling behind; the client reconnects and resumes
+				return
+			}
+			if err := writeEvent(w, update); err != nil {
+				return
+			}
+
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// writeEvent writes a single SSE message. Snapshots carry no ID so they
+// don't move the client's Last-Event-ID.
+func writeEvent(w http.ResponseWriter, update model.LiveUpdate) error {
+	data, err := json.Marshal(update)
+	if err != nil {
+		return err
+	}
+
+	if update.ID > 0 {
+		if _, err := fmt.Fprintf(w, "id: %d\n", update.ID); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", update.Type, data)
+	return err
+}
+
+// lastEventID reads the resume position from the Last-Event-ID header,
+// falling back to a lastEventId query parameter for clients that can't set
+// headers.
+func lastEventID(r *http.Request) uint64 {
+	value := r.Header.Get("Last-Event-ID")
+	if value == "" {
+		value = r.URL.Query().Get("lastEventId")
+	}
+	id, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return id
+}
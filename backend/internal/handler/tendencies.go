@@ -1,12 +1,14 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
 	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/service"
 )
 
@@ -42,19 +44,89 @@ func (h *TendenciesHandler) GetVenueTendencies(w http.ResponseWriter, r *http.Re
 	// Parse date filter parameters
 	dateFilter := parseDateFilter(r)
 
-	tendencies, err := h.svc.GetVenueTendencies(r.Context(), venueID, dateFilter)
+	scope, ok := parseTendencyScope(w, r)
+	if !ok {
+		return
+	}
+
+	tendencies, err := h.svc.GetVenueTendencies(r.Context(), venueID, dateFilter, scope)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
+		switch {
+		case errors.Is(err, service.ErrCourtNotFound):
+			WriteError(w, http.StatusNotFound, "court not found")
+		case strings.Contains(err.Error(), "not found"):
 			WriteError(w, http.StatusNotFound, "venue not found")
-			return
+		default:
+			WriteError(w, http.StatusInternalServerError, "failed to get tendencies")
 		}
-		WriteError(w, http.StatusInternalServerError, "failed to get tendencies")
 		return
 	}
 
 	WriteJSON(w, http.StatusOK, tendencies)
 }
 
+// GetVenueTendencyBreakdown handles GET /api/venues/:id/tendencies/breakdown?by=court|surface
+// Returns the venue's tendencies split by court or by surface.
+func (h *TendenciesHandler) GetVenueTendencyBreakdown(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	venueID := extractVenueIDFromPath(r.URL.Path)
+	if venueID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid venue id")
+		return
+	}
+
+	by := r.URL.Query().Get("by")
+	if by == "" {
+		by = "court"
+	}
+
+	breakdown, err := h.svc.GetVenueTendencyBreakdown(r.Context(), venueID, parseDateFilter(r), by)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidBreakdown):
+			WriteError(w, http.StatusBadRequest, err.Error())
+		case strings.Contains(err.Error(), "not found"):
+			WriteError(w, http.StatusNotFound, "venue not found")
+		default:
+			WriteError(w, http.StatusInternalServerError, "failed to get tendencies")
+		}
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, breakdown)
+}
+
+// parseTendencyScope extracts the optional court_id and surface parameters.
+// Writes a 400 and returns false if either is invalid.
+func parseTendencyScope(w http.ResponseWriter, r *http.Request) (service.TendencyScope, bool) {
+	var scope service.TendencyScope
+	query := r.URL.Query()
+
+	if raw := query.Get("court_id"); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			WriteError(w, http.StatusBadRequest, "invalid court_id")
+			return scope, false
+		}
+		scope.CourtID = &id
+	}
+
+	if raw := query.Get("surface"); raw != "" {
+		surface := model.Surface(raw)
+		if !validSurfaces[surface] {
+			WriteError(w, http.StatusBadRequest, "surface must be hard, clay, or grass")
+			return scope, false
+		}
+		scope.Surface = &surface
+	}
+
+	return scope, true
+}
+
 // parseDateFilter extracts date filtering parameters from the request.
 // Supports:
 // - period=day (today only)
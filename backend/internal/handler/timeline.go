@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+)
+
+const (
+	defaultTimelineLimit = 200
+	maxTimelineLimit     = 1000
+)
+
+// timelineColumns is the header row of a CSV timeline export.
+var timelineColumns = []string{
+	"number", "event_id", "timestamp", "elapsed_seconds",
+	"set_number", "game_number", "server_player_id", "server_team", "serve_type", "winner_team",
+	"sets_before", "games_before", "points_before",
+	"sets_after", "games_after", "points_after",
+	"game_won_by", "set_won_by", "match_won_by", "break",
+	"break_point", "set_point_for", "match_point_for",
+}
+
+// Timeline handles GET /api/matches/:id/timeline?offset=0&limit=200
+// Returns every point in order with the running score. format=csv
+// downloads the whole timeline instead of a page.
+func (h *MatchHandler) Timeline(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	matchID := extractMatchIDFromPath(r.URL.Path)
+	if matchID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	query := r.URL.Query()
+	format := query.Get("format")
+	if format != "" && format != "json" && format != "csv" {
+		WriteError(w, http.StatusBadRequest, "format must be json or csv")
+		return
+	}
+
+	offset, limit := 0, defaultTimelineLimit
+	if value := query.Get("offset"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			WriteError(w, http.StatusBadRequest, "invalid offset")
+			return
+		}
+		offset = parsed
+	}
+	if value := query.Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > maxTimelineLimit {
+			WriteError(w, http.StatusBadRequest, fmt.Sprintf("limit must be between 1 and %d", maxTimelineLimit))
+			return
+		}
+		limit = parsed
+	}
+
+	// Exports always contain the full match
+	if format == "csv" {
+		offset, limit = 0, 0
+	}
+
+	timeline, err := h.svc.GetTimeline(r.Context(), matchID, offset, limit)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			WriteError(w, http.StatusNotFound, "match not found")
+			return
+		}
+		WriteError(w, http.StatusInternalServerError, "failed to get match timeline")
+		return
+	}
+
+	if format == "csv" {
+		writeTimelineCSV(w, timeline)
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, timeline)
+}
+
+// writeTimelineCSV writes a timeline as a CSV attachment.
+func writeTimelineCSV(w http.ResponseWriter, timeline *model.MatchTimeline) {
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"match-%s-timeline.csv\"", timeline.MatchID))
+	w.WriteHeader(http.StatusOK)
+
+	cw := csv.NewWriter(w)
+	_ = cw.Write(timelineColumns)
+	for _, p := range timeline.Points {
+		elapsed := ""
+		if p.ElapsedSeconds != nil {
+			elapsed = strconv.FormatFloat(*p.ElapsedSeconds, 'f', 3, 64)
+		}
+
+		_ = cw.Write([]string{
+			strconv.Itoa(p.Number),
+			p.EventID.String(),
+			p.Timestamp.UTC().Format(time.RFC3339Nano),
+			elapsed,
+			strconv.Itoa(p.SetNumber),
+			strconv.Itoa(p.GameNumber),
+			p.ServerPlayerID.String(),
+			string(p.ServerTeam),
+			string(p.ServeType),
+			string(p.WinnerTeam),
+			fmt.Sprintf("%d-%d", p.Before.SetsA, p.Before.SetsB),
+			fmt.Sprintf("%d-%d", p.Before.GamesA, p.Before.GamesB),
+			p.Before.PointsA + "-" + p.Before.PointsB,
+			fmt.Sprintf("%d-%d", p.After.SetsA, p.After.SetsB),
+			fmt.Sprintf("%d-%d", p.After.GamesA, p.After.GamesB),
+			p.After.PointsA + "-" + p.After.PointsB,
+			teamOrEmpty(p.GameWonBy),
+			teamOrEmpty(p.SetWonBy),
+			teamOrEmpty(p.MatchWonBy),
+			strconv.FormatBool(p.Break),
+			strconv.FormatBool(p.BreakPoint),
+			teamOrEmpty(p.SetPointFor),
+			teamOrEmpty(p.MatchPointFor),
+		})
+	}
+	cw.Flush()
+}
+
+// teamOrEmpty formats an optional team for CSV output.
+func teamOrEmpty(team *model.Team) string {
+	if team == nil {
+		return ""
+	}
+	return string(*team)
+}
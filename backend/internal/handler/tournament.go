@@ -0,0 +1,390 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/service"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/tournament"
+)
+
+// TournamentHandler handles tournament endpoints.
+type TournamentHandler struct {
+	svc *service.TournamentService
+}
+
+// NewTournamentHandler creates a new tournament handler.
+func NewTournamentHandler(svc *service.TournamentService) *TournamentHandler {
+	return &TournamentHandler{svc: svc}
+}
+
+// validTeamModes is a set of valid team creation modes.
+var validTeamModes = map[tournament.TeamCreationMode]bool{
+	tournament.ModeRandom:   true,
+	tournament.ModeManual:   true,
+	tournament.ModeBalanced: true,
+}
+
+// Create handles POST /api/tournaments
+func (h *TournamentHandler) Create(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req service.CreateTournamentRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.VenueID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "venue_id is required")
+		return
+	}
+
+	if len(req.PlayerIDs) == 0 {
+		WriteError(w, http.StatusBadRequest, "player_ids is required")
+		return
+	}
+
+	t, err := h.svc.CreateTournament(r.Context(), req)
+	if err != nil {
+		writeTournamentError(w, err, "failed to create tournament")
+		return
+	}
+
+	WriteJSON(w, http.StatusCreated, t)
+}
+
+// List handles GET /api/tournaments?venue_id=
+func (h *TournamentHandler) List(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var venueID *uuid.UUID
+	if value := r.URL.Query().Get("venue_id"); value != "" {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			WriteError(w, http.StatusBadRequest, "invalid venue_id")
+			return
+		}
+		venueID = &id
+	}
+
+	tournaments, err := h.svc.ListTournaments(r.Context(), venueID)
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "failed to list tournaments")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, tournaments)
+}
+
+// Get handles GET /api/tournaments/:id
+func (h *TournamentHandler) Get(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	id, ok := tournamentID(w, r)
+	if !ok {
+		return
+	}
+
+	t, err := h.svc.GetTournament(r.Context(), id)
+	if err != nil {
+		writeTournamentError(w, err, "failed to get tournament")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, t)
+}
+
+// SetTeams handles POST /api/tournaments/:id/teams
+// Body: {"mode": "random|manual|balanced", "seed": 42, "pairs": [[p1, p2]],
+// "together": [[p1, p2]], "apart": [[p1, p2]], "avoid_repeats": true,
+// "lookback_days": 28}
+func (h *TournamentHandler) SetTeams(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	id, ok := tournamentID(w, r)
+	if !ok {
+		return
+	}
+
+	var req service.SetTeamsRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if !validTeamModes[req.Mode] {
+		WriteError(w, http.StatusBadRequest, "mode must be random, manual or balanced")
+		return
+	}
+
+	if req.Mode == tournament.ModeManual && len(req.Pairs) == 0 {
+		WriteError(w, http.StatusBadRequest, "pairs is required in manual mode")
+		return
+	}
+
+	if req.LookbackDays < 0 {
+		WriteError(w, http.StatusBadRequest, "lookback_days must not be negative")
+		return
+	}
+
+	t, err := h.svc.SetTeams(r.Context(), id, req)
+	if err != nil {
+		writeTournamentError(w, err, "failed to set teams")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, t)
+}
+
+// Matches handles GET /api/tournaments/:id/matches
+func (h *TournamentHandler) Matches(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	id, ok := tournamentID(w, r)
+	if !ok {
+		return
+	}
+
+	matches, err := h.svc.GetMatches(r.Context(), id)
+	if err != nil {
+		writeTournamentError(w, err, "failed to get matches")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, matches)
+}
+
+// NextMatch handles GET /api/tournaments/:id/next-match
+// Data is null when no match can be played until the tournament moves on.
+func (h *TournamentHandler) NextMatch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	id, ok := tournamentID(w, r)
+	if !ok {
+		return
+	}
+
+	match, err := h.svc.GetNextMatch(r.Context(), id)
+	if err != nil {
+		writeTournamentError(w, err, "failed to get next match")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, match)
+}
+
+// RecordResult handles POST /api/tournaments/:id/matches/:matchId/result
+// Body: {"winner_team_id": "...", "score": "6-4 6-3", "mode": "standard"};
+// score and mode are optional.
+func (h *TournamentHandler) RecordResult(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	id, ok := tournamentID(w, r)
+	if !ok {
+		return
+	}
+
+	matchID := extractPathID(r.URL.Path, "matches")
+	if matchID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	var req service.RecordTournamentResultRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.WinnerTeamID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "winner_team_id is required")
+		return
+	}
+
+	if req.Mode != "" && !validMatchModes[req.Mode] {
+		WriteError(w, http.StatusBadRequest, "mode must be standard or short")
+		return
+	}
+
+	t, err := h.svc.RecordResult(r.Context(), id, matchID, req)
+	if err != nil {
+		writeTournamentError(w, err, "failed to record result")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, t)
+}
+
+// StartMatch handles POST /api/tournaments/:id/matches/:matchId/start
+// Creates the scoring match for a tournament match, with the tournament's
+// venue and teams. Body: {"mode": "standard|short", "servers": [...],
+// "court_id": "...", "scheduled_at": "..."}, all optional.
+func (h *TournamentHandler) StartMatch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	id, ok := tournamentID(w, r)
+	if !ok {
+		return
+	}
+
+	matchID := extractPathID(r.URL.Path, "matches")
+	if matchID == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	var req service.StartTournamentMatchRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.Mode != "" && !validMatchModes[req.Mode] {
+		WriteError(w, http.StatusBadRequest, "mode must be standard or short")
+		return
+	}
+
+	if req.ScheduledAt != nil && req.ScheduledAt.IsZero() {
+		WriteError(w, http.StatusBadRequest, "invalid scheduled_at")
+		return
+	}
+
+	match, err := h.svc.StartMatch(r.Context(), id, matchID, req)
+	if err != nil {
+		writeTournamentError(w, err, "failed to start match")
+		return
+	}
+
+	WriteJSON(w, http.StatusCreated, match)
+}
+
+// Advance handles POST /api/tournaments/:id/advance
+// Moves a completed round robin to the knockout stage.
+func (h *TournamentHandler) Advance(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	id, ok := tournamentID(w, r)
+	if !ok {
+		return
+	}
+
+	t, err := h.svc.AdvanceToKnockout(r.Context(), id)
+	if err != nil {
+		writeTournamentError(w, err, "failed to advance tournament")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, t)
+}
+
+// PrepareFinal handles POST /api/tournaments/:id/final
+// Puts the semifinal winners into the final.
+func (h *TournamentHandler) PrepareFinal(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	id, ok := tournamentID(w, r)
+	if !ok {
+		return
+	}
+
+	t, err := h.svc.PrepareFinal(r.Context(), id)
+	if err != nil {
+		writeTournamentError(w, err, "failed to prepare final")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, t)
+}
+
+// Standings handles GET /api/tournaments/:id/standings
+func (h *TournamentHandler) Standings(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	id, ok := tournamentID(w, r)
+	if !ok {
+		return
+	}
+
+	standings, err := h.svc.GetStandings(r.Context(), id)
+	if err != nil {
+		writeTournamentError(w, err, "failed to get standings")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, standings)
+}
+
+// tournamentID reads the tournament ID from the path. Writes a 400 and
+// returns false if invalid.
+func tournamentID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id := extractPathID(r.URL.Path, "tournaments")
+	if id == uuid.Nil {
+		WriteError(w, http.StatusBadRequest, "invalid tournament id")
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// writeTournamentError maps tournament errors to responses: 409 when the
+// tournament's state does not allow the action, 422 when the engine
+// rejects the input.
+func writeTournamentError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		WriteError(w, http.StatusNotFound, "tournament not found")
+	case errors.Is(err, tournament.ErrMatchNotFound):
+		WriteError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrInvalidTournament):
+		WriteError(w, http.StatusUnprocessableEntity, err.Error())
+	case errors.Is(err, tournament.ErrTeamsAlreadySet),
+		errors.Is(err, tournament.ErrNotInRoundRobin),
+		errors.Is(err, tournament.ErrRoundRobinIncomplete),
+		errors.Is(err, tournament.ErrNotInKnockout),
+		errors.Is(err, tournament.ErrSemifinalsIncomplete),
+		errors.Is(err, tournament.ErrMatchCompleted),
+		errors.Is(err, tournament.ErrMatchLinked),
+		errors.Is(err, tournament.ErrNoResult),
+		errors.Is(err, tournament.ErrResultLocked),
+		errors.Is(err, service.ErrFinalNotReady),
+		errors.Is(err, repository.ErrVersionConflict):
+		WriteError(w, http.StatusConflict, err.Error())
+	default:
+		WriteError(w, http.StatusInternalServerError, message)
+	}
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"net/http"
+	"sync"
+)
+
+// ConnLimiter caps the number of concurrent requests per client IP.
+// It is intended for long-lived connections such as event streams, where
+// a request rate limit doesn't bound resource usage.
+type ConnLimiter struct {
+	mu     sync.Mutex
+	active map[string]int
+	max    int
+}
+
+// NewConnLimiter creates a limiter allowing maxPerIP concurrent connections.
+func NewConnLimiter(maxPerIP int) *ConnLimiter {
+	return &ConnLimiter{
+		active: make(map[string]int),
+		max:    maxPerIP,
+	}
+}
+
+// acquire reserves a connection slot for the IP.
+func (cl *ConnLimiter) acquire(ip string) bool {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+
+	if cl.active[ip] >= cl.max {
+		return false
+	}
+	cl.active[ip]++
+	return true
+}
+
+// release frees a connection slot for the IP.
+func (cl *ConnLimiter) release(ip string) {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+
+	cl.active[ip]--
+	if cl.active[ip] <= 0 {
+		delete(cl.active, ip)
+	}
+}
+
+// Limit middleware rejects requests beyond the per-IP connection cap.
+func (cl *ConnLimiter) Limit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := getClientIP(r)
+		if !cl.acquire(ip) {
+			http.Error(w, "too many connections", http.StatusTooManyRequests)
+			return
+		}
+		defer cl.release(ip)
+
+		next.ServeHTTP(w, r)
+	})
+}
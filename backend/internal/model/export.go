@@ -0,0 +1,21 @@
+package model
+
+// MatchExport is a match with its points and computed stats, as written by
+// the JSON and NDJSON exports.
+type MatchExport struct {
+	Match     Match  `json:"match"`
+	VenueName string `json:"venue_name"`
+
+	// Players lists each player with their serve statistics
+	Players []PlayerMatchStats `json:"players"`
+
+	// Teams holds the stats of each doubles side
+	Teams []TeamMatchStats `json:"teams,omitempty"`
+
+	// Sets holds the games of every completed set (standard mode)
+	Sets    []SetScore `json:"sets"`
+	PointsA int        `json:"points_a"`
+	PointsB int        `json:"points_b"`
+
+	Events []PointEvent `json:"events"`
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HeadToHead is the record between two sides: a player or a doubles pair
+// each. A side only counts matches in which it played exactly as given, so
+// a pair's record leaves out matches where the partners faced B apart.
+type HeadToHead struct {
+	A HeadToHeadSide `json:"a"`
+	B HeadToHeadSide `json:"b"`
+
+	// Matches are newest first, scored from side A's point of view
+	Matches []HeadToHeadMatch `json:"matches"`
+}
+
+// HeadToHeadSide is one side's record in a head-to-head.
+type HeadToHeadSide struct {
+	// Key is the player ID, or the sorted-pair team ID used by venue
+	// tendencies for a doubles pair
+	Key         string      `json:"key"`
+	PlayerIDs   []uuid.UUID `json:"player_ids"`
+	PlayerNames []string    `json:"player_names"`
+
+	Wins     int `json:"wins"`
+	Losses   int `json:"losses"`
+	SetsWon  int `json:"sets_won"`
+	GamesWon int `json:"games_won"`
+
+	// Serve averages over the point-tracked matches only
+	Serve ServeAverages `json:"serve"`
+}
+
+// HeadToHeadMatch is a match between the two sides.
+type HeadToHeadMatch struct {
+	MatchID   uuid.UUID `json:"match_id"`
+	VenueID   uuid.UUID `json:"venue_id"`
+	MatchType MatchType `json:"match_type"`
+	Mode      MatchMode `json:"mode"`
+	StartedAt time.Time `json:"started_at"`
+
+	// Winner is "a" or "b", or empty when the match has no recorded winner
+	Winner string `json:"winner,omitempty"`
+
+	// Score is from side A's point of view, e.g. "6-4 3-6 7-5"
+	Score      string `json:"score,omitempty"`
+	ResultOnly bool   `json:"result_only,omitempty"`
+}
@@ -0,0 +1,15 @@
+package model
+
+// MatchHistoryEntry is a match in the history listing with its players.
+type MatchHistoryEntry struct {
+	Match   Match         `json:"match"`
+	Players []MatchPlayer `json:"players"`
+}
+
+// MatchHistory is one page of the match history, newest first.
+type MatchHistory struct {
+	Matches []MatchHistoryEntry `json:"matches"`
+
+	// NextCursor fetches the following page; empty on the last page
+	NextCursor string `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,19 @@
+package model
+
+import "github.com/google/uuid"
+
+// ImportLineError is a problem with one line of a match import.
+type ImportLineError struct {
+	Line  int    `json:"line"`
+	Error string `json:"error"`
+}
+
+// ImportResult reports the outcome of a match import. Lines are only
+// imported when every line is valid.
+type ImportResult struct {
+	Lines    int               `json:"lines"`
+	Imported int               `json:"imported"`
+	DryRun   bool              `json:"dry_run"`
+	MatchIDs []uuid.UUID       `json:"match_ids"`
+	Errors   []ImportLineError `json:"errors"`
+}
@@ -0,0 +1,90 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/scoring"
+)
+
+// SetScore is the final game score of a completed set.
+type SetScore struct {
+	GamesA int `json:"games_a"`
+	GamesB int `json:"games_b"`
+
+	// TieBreak is the loser's points in the set's tie-break, if one was played
+	TieBreak *int `json:"tie_break,omitempty"`
+}
+
+// String returns the set as "games-games" from Team A's side, with the
+// tie-break points in brackets, e.g. "7-6(5)".
+func (s SetScore) String() string {
+	if s.TieBreak != nil {
+		return fmt.Sprintf("%d-%d(%d)", s.GamesA, s.GamesB, *s.TieBreak)
+	}
+	return fmt.Sprintf("%d-%d", s.GamesA, s.GamesB)
+}
+
+// LiveMatchState is the current score of a match as replayed from its
+// stored point events. It lets any device follow a match that is being
+// scored elsewhere.
+type LiveMatchState struct {
+	MatchID uuid.UUID   `json:"match_id"`
+	VenueID uuid.UUID   `json:"venue_id"`
+	Mode    MatchMode   `json:"mode"`
+	Status  MatchStatus `json:"status"`
+
+	// Display is the scoring engine's view of the current game, games and sets
+	Display scoring.MatchDisplay `json:"display"`
+
+	// ServerPlayerID is the player serving the current game, if known.
+	// Short-format matches use the fixed serving order; standard matches
+	// report the server of the game in progress once a point has been played.
+	ServerPlayerID *uuid.UUID `json:"server_player_id,omitempty"`
+
+	// Sets lists completed sets in order (standard mode only)
+	Sets []SetScore `json:"sets"`
+
+	// PointsPlayed is the number of point events applied
+	PointsPlayed int `json:"points_played"`
+
+	// LastSeq is the sequence number of the last point applied
+	LastSeq int `json:"last_seq"`
+
+	// Winner is set once the scoring engine has decided the match
+	Winner *Team `json:"winner,omitempty"`
+
+	// Decided is true when the scoring engine considers the match over
+	Decided bool `json:"decided"`
+
+	// EndedAt is set once the match has been marked complete
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+
+	// UpdatedAt is when this state was last recomputed
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// LiveUpdateType describes what changed in a live update.
+type LiveUpdateType string
+
+const (
+	LiveUpdateSnapshot       LiveUpdateType = "snapshot"
+	LiveUpdatePoint          LiveUpdateType = "point"
+	LiveUpdatePointVoided    LiveUpdateType = "point_voided"
+	LiveUpdateMatchStarted   LiveUpdateType = "match_started"
+	LiveUpdateMatchCompleted LiveUpdateType = "match_completed"
+	LiveUpdateMatchSuspended LiveUpdateType = "match_suspended"
+	LiveUpdateMatchResumed   LiveUpdateType = "match_resumed"
+	LiveUpdateMatchAbandoned LiveUpdateType = "match_abandoned"
+	LiveUpdatePointCorrected LiveUpdateType = "point_corrected"
+	LiveUpdateConflict       LiveUpdateType = "conflict"
+)
+
+// LiveUpdate is a single message pushed to live match subscribers.
+type LiveUpdate struct {
+	// ID orders updates for Last-Event-ID resume (0 for snapshots)
+	ID    uint64          `json:"id"`
+	Type  LiveUpdateType  `json:"type"`
+	State *LiveMatchState `json:"state"`
+}
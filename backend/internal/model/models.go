@@ -24,6 +24,26 @@ const (
 	MatchTypeAustralianDoubles MatchType = "1v2"
 )
 
+// MatchMode represents the scoring format used for a match.
+// Values mirror scoring.MatchMode so stored matches can be replayed.
+type MatchMode string
+
+const (
+	MatchModeStandard MatchMode = "standard"
+	MatchModeShort    MatchMode = "short"
+)
+
+// MatchStatus represents where a match is in its lifecycle.
+type MatchStatus string
+
+const (
+	MatchStatusScheduled  MatchStatus = "scheduled"
+	MatchStatusInProgress MatchStatus = "in_progress"
+	MatchStatusSuspended  MatchStatus = "suspended"
+	MatchStatusCompleted  MatchStatus = "completed"
+	MatchStatusAbandoned  MatchStatus = "abandoned"
+)
+
 // Team represents team A or B.
 type Team string
 
@@ -39,6 +59,10 @@ const (
 	ServeTypeFirst       ServeType = "first"
 	ServeTypeSecond      ServeType = "second"
 	ServeTypeDoubleFault ServeType = "double_fault"
+
+	// ServeTypeUnknown is used for imported points where only the winner
+	// of the point was recorded. Serve statistics skip these points.
+	ServeTypeUnknown ServeType = "unknown"
 )
 
 // Player represents a tennis player.
@@ -58,14 +82,68 @@ type Venue struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// Court represents a single court at a venue.
+type Court struct {
+	ID      uuid.UUID `json:"id"`
+	VenueID uuid.UUID `json:"venue_id"`
+	Name    string    `json:"name"`
+
+	// Surface overrides the venue's surface (nil = same as venue)
+	Surface *Surface `json:"surface,omitempty"`
+
+	Indoor    bool      `json:"indoor"`
+	Lights    bool      `json:"lights"` // Floodlit for evening play
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// EffectiveSurface returns the court's surface, falling back to the venue's.
+func (c *Court) EffectiveSurface(venue *Venue) Surface {
+	if c.Surface != nil {
+		return *c.Surface
+	}
+	return venue.Surface
+}
+
 // Match represents a tennis match.
 type Match struct {
-	ID        uuid.UUID  `json:"id"`
-	VenueID   uuid.UUID  `json:"venue_id"`
-	MatchType MatchType  `json:"match_type"`
-	StartedAt time.Time  `json:"started_at"`
-	EndedAt   *time.Time `json:"ended_at,omitempty"`
-	CreatedAt time.Time  `json:"created_at"`
+	ID        uuid.UUID   `json:"id"`
+	VenueID   uuid.UUID   `json:"venue_id"`
+	MatchType MatchType   `json:"match_type"`
+	Mode      MatchMode   `json:"mode"`
+	Servers   []uuid.UUID `json:"servers,omitempty"` // Short-format serving order
+	BestOf    int         `json:"best_of,omitempty"` // Short-format games, 3 or 5
+	CourtID   *uuid.UUID  `json:"court_id,omitempty"`
+	Status    MatchStatus `json:"status"`
+
+	// TieBreaks plays a tie-break at 6-6 (standard mode). Only imported
+	// matches use it; live scoring plays the 6-6 game as a normal game.
+	TieBreaks bool `json:"tie_breaks,omitempty"`
+
+	// ScheduledAt is the planned start of a match created ahead of time.
+	// StartedAt holds the same time until the match is started.
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
+	StartedAt   time.Time  `json:"started_at"`
+	EndedAt     *time.Time `json:"ended_at,omitempty"` // Set when completed or abandoned
+	CreatedAt   time.Time  `json:"created_at"`
+
+	// WinnerTeam and Score are stored when the match is completed.
+	// Score is from Team A's side, e.g. "6-4 3-6 7-5".
+	WinnerTeam *Team  `json:"winner_team,omitempty"`
+	Score      string `json:"score,omitempty"`
+
+	// ResultOnly marks a match recorded by its final score, with no points.
+	// It counts in win/loss records but not in point or game statistics.
+	ResultOnly bool `json:"result_only,omitempty"`
+}
+
+// MatchSuspension is a period during which play was stopped.
+type MatchSuspension struct {
+	ID          uuid.UUID  `json:"id"`
+	MatchID     uuid.UUID  `json:"match_id"`
+	Reason      string     `json:"reason,omitempty"`
+	SuspendedAt time.Time  `json:"suspended_at"`
+	ResumedAt   *time.Time `json:"resumed_at,omitempty"` // nil while still suspended
 }
 
 // MatchPlayer represents the association between a match and a player.
@@ -79,12 +157,31 @@ type MatchPlayer struct {
 type PointEvent struct {
 	ID              uuid.UUID `json:"id"`
 	MatchID         uuid.UUID `json:"match_id"`
+	Seq             int       `json:"seq"` // Position within the match, from 1
 	Timestamp       time.Time `json:"timestamp"`
 	ServerPlayerID  uuid.UUID `json:"server_player_id"`
 	ServeType       ServeType `json:"serve_type"`
 	PointWinnerTeam Team      `json:"point_winner_team"`
 }
 
+// SeqRange is an inclusive range of point sequence numbers.
+type SeqRange struct {
+	From int `json:"from"`
+	To   int `json:"to"`
+}
+
+// AddEventsResult reports the outcome of storing a batch of point events.
+type AddEventsResult struct {
+	Inserted int `json:"inserted"`
+	Total    int `json:"total"`
+
+	// LastSeq is the highest sequence number recorded for the match
+	LastSeq int `json:"last_seq"`
+
+	// Missing lists sequence numbers below LastSeq that have not been received
+	Missing []SeqRange `json:"missing"`
+}
+
 // MatchWithDetails includes match info with related data.
 type MatchWithDetails struct {
 	Match   Match         `json:"match"`
@@ -95,18 +192,28 @@ type MatchWithDetails struct {
 
 // MatchSummary contains computed statistics for a completed match.
 type MatchSummary struct {
-	MatchID     uuid.UUID          `json:"match_id"`
-	Venue       Venue              `json:"venue"`
-	MatchType   MatchType          `json:"match_type"`
-	StartedAt   time.Time          `json:"started_at"`
-	EndedAt     *time.Time         `json:"ended_at,omitempty"`
-	TeamAScore  int                `json:"team_a_score"`  // Total points
-	TeamBScore  int                `json:"team_b_score"`  // Total points
-	GamesA      int                `json:"games_a"`       // Games won by Team A
-	GamesB      int                `json:"games_b"`       // Games won by Team B
-	SetsA       int                `json:"sets_a"`        // Sets won by Team A (standard mode only)
-	SetsB       int                `json:"sets_b"`        // Sets won by Team B (standard mode only)
-	PlayerStats []PlayerMatchStats `json:"player_stats"`
+	MatchID        uuid.UUID          `json:"match_id"`
+	Venue          Venue              `json:"venue"`
+	MatchType      MatchType          `json:"match_type"`
+	Mode           MatchMode          `json:"mode"`
+	Status         MatchStatus        `json:"status"`
+	StartedAt      time.Time          `json:"started_at"`
+	EndedAt        *time.Time         `json:"ended_at,omitempty"`
+	Suspensions    []MatchSuspension  `json:"suspensions"`
+	PlayingSeconds int64              `json:"playing_seconds"` // Excludes suspended time
+	TeamAScore     int                `json:"team_a_score"`    // Total points
+	TeamBScore     int                `json:"team_b_score"`    // Total points
+	GamesA         int                `json:"games_a"`         // Games won by Team A
+	GamesB         int                `json:"games_b"`         // Games won by Team B
+	SetsA          int                `json:"sets_a"`          // Sets won by Team A (standard mode only)
+	SetsB          int                `json:"sets_b"`          // Sets won by Team B (standard mode only)
+	Sets           []SetScore         `json:"sets"`            // Completed sets in order (standard mode only)
+	WinnerTeam     *Team              `json:"winner_team,omitempty"`
+	Score          string             `json:"score,omitempty"`
+	ResultOnly     bool               `json:"result_only,omitempty"` // No points; only games and sets are known
+	PlayerStats    []PlayerMatchStats `json:"player_stats"`
+	TeamStats      []TeamMatchStats   `json:"team_stats,omitempty"` // Sides with more than one player
+	Pace           *MatchPace         `json:"pace,omitempty"`       // Omitted for result-only matches
 }
 
 // PlayerMatchStats contains serve statistics for a player in a match.
@@ -121,5 +228,49 @@ type PlayerMatchStats struct {
 	SecondServesTotal int       `json:"second_serves_total"`
 	SecondServeWon    int       `json:"second_serve_won"`
 	DoubleFaults      int       `json:"double_faults"`
-	TotalPointsWon    int       `json:"total_points_won"`
+
+	// TotalPointsWon counts the points the player can be credited with:
+	// every point their side won when they play alone, otherwise only the
+	// points won on their own serve.
+	TotalPointsWon int `json:"total_points_won"`
+
+	// Service games exclude tie-breaks, which have no single server
+	ServicePointsWon   int `json:"service_points_won"`
+	ServiceGamesPlayed int `json:"service_games_played"`
+	ServiceGamesHeld   int `json:"service_games_held"`
+	BreakPointsFaced   int `json:"break_points_faced"`
+	BreakPointsSaved   int `json:"break_points_saved"`
+
+	// Side stats are only filled in for a player alone on their side; a
+	// doubles pair shares them in its TeamMatchStats.
+	SideMatchStats
+}
+
+// SideMatchStats are statistics of one side of a match that can't be
+// split between partners, as the returner isn't recorded.
+type SideMatchStats struct {
+	ReturnPointsPlayed   int `json:"return_points_played"`
+	ReturnPointsWon      int `json:"return_points_won"`
+	BreakPointChances    int `json:"break_point_chances"`
+	BreakPointsConverted int `json:"break_points_converted"`
+	DeuceGamesPlayed     int `json:"deuce_games_played"`
+	DeuceGamesWon        int `json:"deuce_games_won"`
+	LongestPointStreak   int `json:"longest_point_streak"`
+
+	// Points in the last 4 games of each set (of the match in short-format)
+	Last4PointsPlayed int `json:"last4_points_played"`
+	Last4PointsWon    int `json:"last4_points_won"`
+}
+
+// TeamMatchStats contains the statistics of a doubles side in a match.
+type TeamMatchStats struct {
+	Team               Team        `json:"team"`
+	PlayerIDs          []uuid.UUID `json:"player_ids"`
+	PointsWon          int         `json:"points_won"`
+	ServicePointsWon   int         `json:"service_points_won"`
+	ServiceGamesPlayed int         `json:"service_games_played"`
+	ServiceGamesHeld   int         `json:"service_games_held"`
+	BreakPointsFaced   int         `json:"break_points_faced"`
+	BreakPointsSaved   int         `json:"break_points_saved"`
+	SideMatchStats
 }
@@ -0,0 +1,51 @@
+package model
+
+import "github.com/google/uuid"
+
+// GamePace is how long a game took. A game's time runs from the end of the
+// point before it to the end of its last point, less any suspension.
+type GamePace struct {
+	Set     int   `json:"set"`
+	Game    int   `json:"game"`
+	Points  int   `json:"points"`
+	Seconds int64 `json:"seconds"`
+}
+
+// SetPace is how long a set took. Short-format matches have a single set.
+type SetPace struct {
+	Set     int   `json:"set"`
+	Games   int   `json:"games"`
+	Points  int   `json:"points"`
+	Seconds int64 `json:"seconds"`
+}
+
+// MatchPace reports how long a match and its parts took, from the point
+// timestamps.
+type MatchPace struct {
+	MatchID                 uuid.UUID `json:"match_id"`
+	Mode                    MatchMode `json:"mode"`
+	PlayingSeconds          int64     `json:"playing_seconds"` // Excludes suspended time
+	Points                  int       `json:"points"`
+	AvgSecondsBetweenPoints float64   `json:"avg_seconds_between_points"`
+	LongestGame             *GamePace `json:"longest_game,omitempty"`
+	Sets                    []SetPace `json:"sets"`
+}
+
+// ModePace aggregates the pace of completed matches of one scoring mode.
+type ModePace struct {
+	Mode                    MatchMode `json:"mode"`
+	Matches                 int       `json:"matches"`
+	AvgPlayingSeconds       int64     `json:"avg_playing_seconds"`
+	MedianPlayingSeconds    int64     `json:"median_playing_seconds"`
+	P90PlayingSeconds       int64     `json:"p90_playing_seconds"`
+	AvgSecondsBetweenPoints float64   `json:"avg_seconds_between_points"`
+
+	// SuggestedSlotMinutes covers 9 in 10 matches, rounded up to 15 minutes
+	SuggestedSlotMinutes int `json:"suggested_slot_minutes"`
+}
+
+// VenuePace aggregates match pace at a venue by scoring mode.
+type VenuePace struct {
+	VenueID uuid.UUID  `json:"venue_id"`
+	Modes   []ModePace `json:"modes"`
+}
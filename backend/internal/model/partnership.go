@@ -0,0 +1,46 @@
+package model
+
+import "github.com/google/uuid"
+
+// Partnership describes a doubles pair across all venues.
+type Partnership struct {
+	// TeamID is the sorted pair key, as in VenueTeamTendency
+	TeamID      string    `json:"team_id"`
+	Player1ID   uuid.UUID `json:"player1_id"`
+	Player2ID   uuid.UUID `json:"player2_id"`
+	Player1Name string    `json:"player1_name"`
+	Player2Name string    `json:"player2_name"`
+
+	// Record together
+	WinLoss
+	WinPercentage float64 `json:"win_percentage"`
+
+	// Each player's doubles record with other partners
+	Player1Apart PartnerRecord `json:"player1_apart"`
+	Player2Apart PartnerRecord `json:"player2_apart"`
+
+	// Chemistry is the win percentage together minus the mean of the two
+	// players' win percentages apart, in percentage points. Nil until both
+	// players have played doubles with someone else.
+	Chemistry *float64 `json:"chemistry,omitempty"`
+
+	// TeamRating and TeamDeviation combine the players' current doubles
+	// ratings the way a side is rated: the mean rating and the root mean
+	// square deviation
+	TeamRating    float64 `json:"team_rating"`
+	TeamDeviation float64 `json:"team_deviation"`
+}
+
+// PartnerRecord is a doubles record with a win percentage.
+type PartnerRecord struct {
+	WinLoss
+	WinPercentage float64 `json:"win_percentage"`
+}
+
+// PlayerPartners lists a player's partnerships, best first. Player 1 of
+// each partnership is the player.
+type PlayerPartners struct {
+	PlayerID     uuid.UUID     `json:"player_id"`
+	PlayerName   string        `json:"player_name"`
+	Partnerships []Partnership `json:"partnerships"`
+}
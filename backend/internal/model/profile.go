@@ -0,0 +1,90 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ServeAverages holds serve statistics averaged over several matches.
+// Percentages are 0-100.
+type ServeAverages struct {
+	// Matches is the number of point-tracked matches the averages cover
+	Matches int `json:"matches"`
+
+	FirstServeInPct         float64 `json:"first_serve_in_pct"`
+	FirstServePointsWonPct  float64 `json:"first_serve_points_won_pct"`
+	SecondServeInPct        float64 `json:"second_serve_in_pct"`
+	SecondServePointsWonPct float64 `json:"second_serve_points_won_pct"`
+	DoubleFaultsPerMatch    float64 `json:"double_faults_per_match"`
+}
+
+// WinLoss is a record over a number of completed matches. Matches without
+// a recorded winner count as played only.
+type WinLoss struct {
+	Matches int `json:"matches"`
+	Wins    int `json:"wins"`
+	Losses  int `json:"losses"`
+}
+
+// PlayerProfile aggregates a player's completed matches across all venues
+// and match types.
+type PlayerProfile struct {
+	Player Player `json:"player"`
+	WinLoss
+
+	ByMatchType []MatchTypeRecord `json:"by_match_type"`
+	BySurface   []SurfaceRecord   `json:"by_surface"`
+
+	// Partners and Opponents are the players met most often, most first
+	Partners  []ProfilePlayerRecord `json:"partners"`
+	Opponents []ProfilePlayerRecord `json:"opponents"`
+
+	// Serve is the career average; ServeTrend has one entry per month
+	// played, oldest first
+	Serve      ServeAverages     `json:"serve"`
+	ServeTrend []ServeTrendPoint `json:"serve_trend"`
+
+	// Form is the last completed matches, newest first
+	Form []FormEntry `json:"form"`
+}
+
+// MatchTypeRecord is a player's record in one match type.
+type MatchTypeRecord struct {
+	MatchType MatchType `json:"match_type"`
+	WinLoss
+}
+
+// SurfaceRecord is a player's record and serve on one surface.
+type SurfaceRecord struct {
+	Surface Surface `json:"surface"`
+	WinLoss
+	Serve ServeAverages `json:"serve"`
+}
+
+// ProfilePlayerRecord is the record of a profile's player alongside or
+// against another player.
+type ProfilePlayerRecord struct {
+	PlayerID   uuid.UUID `json:"player_id"`
+	PlayerName string    `json:"player_name"`
+	WinLoss
+}
+
+// ServeTrendPoint is a player's serve averaged over one calendar month (UTC).
+type ServeTrendPoint struct {
+	Month string `json:"month"` // e.g. "2024-06"
+	ServeAverages
+}
+
+// FormEntry is one of a player's recent matches.
+type FormEntry struct {
+	MatchID   uuid.UUID `json:"match_id"`
+	MatchType MatchType `json:"match_type"`
+	StartedAt time.Time `json:"started_at"`
+
+	// Result is "W" or "L", or empty when the match has no recorded winner
+	Result string `json:"result,omitempty"`
+
+	// Score is from the player's side, e.g. "6-4 3-6 7-5"
+	Score string `json:"score,omitempty"`
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RatingKind is a player's singles or doubles rating.
+// Values mirror rating.Kind.
+type RatingKind string
+
+const (
+	RatingKindSingles RatingKind = "singles"
+	RatingKindDoubles RatingKind = "doubles"
+)
+
+// PlayerRating is a player's current Glicko-2 rating of one kind.
+type PlayerRating struct {
+	PlayerID   uuid.UUID  `json:"player_id"`
+	PlayerName string     `json:"player_name,omitempty"`
+	Kind       RatingKind `json:"kind"`
+	Rating     float64    `json:"rating"`
+	Deviation  float64    `json:"deviation"` // Lower is more certain
+	Volatility float64    `json:"volatility"`
+	Matches    int        `json:"matches"`
+	UpdatedAt  time.Time  `json:"updated_at"` // When the last rated match was played
+}
+
+// RatingChange is how one match moved a player's rating.
+type RatingChange struct {
+	PlayerID     uuid.UUID  `json:"player_id"`
+	MatchID      uuid.UUID  `json:"match_id"`
+	Kind         RatingKind `json:"kind"`
+	PlayedAt     time.Time  `json:"played_at"`
+	RatingBefore float64    `json:"rating_before"`
+	Rating       float64    `json:"rating"`
+	Deviation    float64    `json:"deviation"`
+	Volatility   float64    `json:"volatility"`
+}
+
+// PlayerRatingHistory is a player's current ratings and every change to
+// them, oldest first.
+type PlayerRatingHistory struct {
+	PlayerID uuid.UUID      `json:"player_id"`
+	Ratings  []PlayerRating `json:"ratings"`
+	History  []RatingChange `json:"history"`
+}
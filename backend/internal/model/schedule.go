@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ScheduleEntry is a match on the schedule with its players and court.
+type ScheduleEntry struct {
+	Match   Match         `json:"match"`
+	Players []MatchPlayer `json:"players"`
+	Court   *Court        `json:"court,omitempty"`
+
+	// PlannedEnd is when the match is expected to finish
+	PlannedEnd time.Time `json:"planned_end"`
+}
+
+// ScheduleConflictType describes what is double-booked.
+type ScheduleConflictType string
+
+const (
+	ScheduleConflictPlayer ScheduleConflictType = "player"
+	ScheduleConflictCourt  ScheduleConflictType = "court"
+)
+
+// ScheduleConflict is a player or court booked for two overlapping matches.
+type ScheduleConflict struct {
+	Type     ScheduleConflictType `json:"type"`
+	MatchIDs []uuid.UUID          `json:"match_ids"`
+	PlayerID *uuid.UUID           `json:"player_id,omitempty"`
+	CourtID  *uuid.UUID           `json:"court_id,omitempty"`
+}
+
+// Schedule lists the matches planned or being played on a day.
+type Schedule struct {
+	Date      string             `json:"date"`
+	VenueID   *uuid.UUID         `json:"venue_id,omitempty"`
+	Entries   []ScheduleEntry    `json:"entries"`
+	Conflicts []ScheduleConflict `json:"conflicts"`
+}
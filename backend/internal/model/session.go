@@ -0,0 +1,73 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ConflictResolution records how a human settled a scoring conflict.
+type ConflictResolution string
+
+const (
+	ResolutionKeepCanonical  ConflictResolution = "keep_canonical"
+	ResolutionAcceptProposed ConflictResolution = "accept_proposed"
+)
+
+// PointConflict is raised when two devices scoring the same match disagree
+// on who won a point. The canonical point stays in effect until resolved.
+type PointConflict struct {
+	ID       uuid.UUID `json:"id"`
+	MatchID  uuid.UUID `json:"match_id"`
+	DeviceID string    `json:"device_id"`
+
+	// Position is the zero-based index of the disputed point in the match
+	Position int `json:"position"`
+
+	// CanonicalEventID is the point currently recorded at Position
+	CanonicalEventID uuid.UUID `json:"canonical_event_id"`
+
+	// Proposed is the point the submitting device recorded instead
+	Proposed PointEvent `json:"proposed"`
+
+	Resolution *ConflictResolution `json:"resolution,omitempty"`
+	CreatedAt  time.Time           `json:"created_at"`
+	ResolvedAt *time.Time          `json:"resolved_at,omitempty"`
+}
+
+// SessionDevice is a device taking part in scoring a match.
+type SessionDevice struct {
+	DeviceID      string    `json:"device_id"`
+	LastClientSeq int       `json:"last_client_seq"`
+	LastSeenAt    time.Time `json:"last_seen_at"`
+}
+
+// ScoringSession is the agreed state of a match shared by all scoring devices.
+type ScoringSession struct {
+	MatchID uuid.UUID `json:"match_id"`
+
+	// Cursor is passed back on the next poll to wait for newer changes
+	Cursor uint64 `json:"cursor"`
+
+	State     *LiveMatchState `json:"state"`
+	Conflicts []PointConflict `json:"conflicts"`
+	Devices   []SessionDevice `json:"devices"`
+}
+
+// SessionSubmitResult reports how submitted points were reconciled.
+type SessionSubmitResult struct {
+	// Accepted points were appended to the match
+	Accepted int `json:"accepted"`
+
+	// Merged points matched a point another device already recorded
+	Merged int `json:"merged"`
+
+	// Duplicates were already stored (retries)
+	Duplicates int `json:"duplicates"`
+
+	// Conflict is set when a point disagreed with the recorded history;
+	// points after it in the batch were not applied
+	Conflict *PointConflict `json:"conflict,omitempty"`
+
+	Session *ScoringSession `json:"session"`
+}
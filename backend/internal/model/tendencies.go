@@ -74,6 +74,11 @@ type VenueTendencies struct {
 	// VenueName is the display name of the venue
 	VenueName string `json:"venue_name"`
 
+	// CourtID and Surface are set when tendencies are limited to matches on
+	// one court or one surface
+	CourtID *uuid.UUID `json:"court_id,omitempty"`
+	Surface *Surface   `json:"surface,omitempty"`
+
 	// TeamTendencies contains eligible team tendencies (doubles only, 3+ matches)
 	// Ordered alphabetically by team display name (neutral ordering per spec)
 	TeamTendencies []VenueTeamTendency `json:"team_tendencies"`
@@ -91,3 +96,27 @@ const (
 	// MinPlayerMatchesForTendency is the minimum matches required for player eligibility
 	MinPlayerMatchesForTendency = 5
 )
+
+// VenueTendencyGroup is the tendencies of one court or surface at a venue.
+type VenueTendencyGroup struct {
+	// Court is set when grouping by court, except on the group of matches
+	// played without a court
+	Court *Court `json:"court,omitempty"`
+
+	// Surface is the court's surface (the venue's without a court), or the
+	// surface grouped by
+	Surface Surface `json:"surface"`
+
+	TeamTendencies   []VenueTeamTendency   `json:"team_tendencies"`
+	PlayerTendencies []VenuePlayerTendency `json:"player_tendencies"`
+}
+
+// VenueTendencyBreakdown splits a venue's tendencies by court or surface.
+type VenueTendencyBreakdown struct {
+	VenueID   uuid.UUID `json:"venue_id"`
+	VenueName string    `json:"venue_name"`
+
+	// By is "court" or "surface"
+	By     string               `json:"by"`
+	Groups []VenueTendencyGroup `json:"groups"`
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TimelineScore is the match score at one moment, in display notation.
+type TimelineScore struct {
+	SetsA   int    `json:"sets_a"`
+	SetsB   int    `json:"sets_b"`
+	GamesA  int    `json:"games_a"`
+	GamesB  int    `json:"games_b"`
+	PointsA string `json:"points_a"`
+	PointsB string `json:"points_b"`
+}
+
+// TimelinePoint is a single point of a match with the score around it.
+type TimelinePoint struct {
+	// Number is the 1-based position of the point in the match
+	Number    int       `json:"number"`
+	EventID   uuid.UUID `json:"event_id"`
+	Timestamp time.Time `json:"timestamp"`
+
+	// ElapsedSeconds is the time since the previous point (nil for the first)
+	ElapsedSeconds *float64 `json:"elapsed_seconds,omitempty"`
+
+	ServerPlayerID uuid.UUID `json:"server_player_id"`
+	ServerTeam     Team      `json:"server_team"`
+	ServeType      ServeType `json:"serve_type"`
+	WinnerTeam     Team      `json:"winner_team"`
+
+	// SetNumber and GameNumber locate the point (SetNumber is 1 in short-format)
+	SetNumber  int `json:"set_number"`
+	GameNumber int `json:"game_number"`
+
+	Before TimelineScore `json:"before"`
+	After  TimelineScore `json:"after"`
+
+	// Boundaries reached on this point
+	GameWonBy  *Team `json:"game_won_by,omitempty"`
+	SetWonBy   *Team `json:"set_won_by,omitempty"`
+	MatchWonBy *Team `json:"match_won_by,omitempty"`
+
+	// Break is true when the receiving team won the game on this point
+	Break bool `json:"break"`
+
+	// Pressure flags describe the score before the point was played
+	BreakPoint    bool  `json:"break_point"`
+	SetPointFor   *Team `json:"set_point_for,omitempty"`
+	MatchPointFor *Team `json:"match_point_for,omitempty"`
+}
+
+// MatchTimeline is a page of a match's point-by-point timeline.
+type MatchTimeline struct {
+	MatchID uuid.UUID       `json:"match_id"`
+	Total   int             `json:"total"`
+	Offset  int             `json:"offset"`
+	Limit   int             `json:"limit"`
+	Points  []TimelinePoint `json:"points"`
+}
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tournament is a doubles tournament as run by the tournament engine.
+// Stage values mirror tournament.TournamentStage.
+type Tournament struct {
+	ID           uuid.UUID            `json:"id"`
+	VenueID      uuid.UUID            `json:"venue_id"`
+	PlayerIDs    []uuid.UUID          `json:"player_ids"`
+	Stage        string               `json:"stage"` // setup, round_robin, knockout or completed
+	Teams        []TournamentTeam     `json:"teams"`
+	TeamSeed     *int64               `json:"team_seed,omitempty"`
+	Matches      []TournamentMatch    `json:"matches"` // Round robin, then knockout
+	Standings    []TournamentStanding `json:"standings"`
+	WinnerTeamID *uuid.UUID           `json:"winner_team_id,omitempty"`
+	Completed    bool                 `json:"completed"`
+	Version      int                  `json:"version"` // Goes up with every change
+	CreatedAt    time.Time            `json:"created_at"`
+}
+
+// TournamentSummary is a tournament in a list.
+type TournamentSummary struct {
+	ID           uuid.UUID  `json:"id"`
+	VenueID      uuid.UUID  `json:"venue_id"`
+	PlayerCount  int        `json:"player_count"`
+	Stage        string     `json:"stage"`
+	WinnerTeamID *uuid.UUID `json:"winner_team_id,omitempty"`
+	Completed    bool       `json:"completed"`
+	Version      int        `json:"version"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// TournamentTeam is a doubles pair in a tournament.
+type TournamentTeam struct {
+	ID         uuid.UUID `json:"id"`
+	TeamNumber int       `json:"team_number"`
+	Player1ID  uuid.UUID `json:"player1_id"`
+	Player2ID  uuid.UUID `json:"player2_id"`
+}
+
+// TournamentMatch is a match between two tournament teams.
+type TournamentMatch struct {
+	ID         uuid.UUID `json:"id"`
+	Stage      string    `json:"stage"` // round_robin, semi or final
+	MatchOrder int       `json:"match_order"`
+
+	// TeamAID and TeamBID are nil until known (the final before the
+	// semifinals are played)
+	TeamAID *uuid.UUID `json:"team_a_id"`
+	TeamBID *uuid.UUID `json:"team_b_id"`
+
+	ScoringMatchID *uuid.UUID `json:"scoring_match_id,omitempty"`
+	WinnerTeamID   *uuid.UUID `json:"winner_team_id,omitempty"`
+
+	// Sets and games each team won; 0 if recorded without a score
+	SetsA  int `json:"sets_a"`
+	SetsB  int `json:"sets_b"`
+	GamesA int `json:"games_a"`
+	GamesB int `json:"games_b"`
+
+	Completed bool `json:"completed"`
+}
+
+// TournamentStanding is a team's round-robin record.
+type TournamentStanding struct {
+	TeamID    uuid.UUID `json:"team_id"`
+	Rank      int       `json:"rank"` // 0 until the first result
+	Played    int       `json:"played"`
+	Won       int       `json:"won"`
+	Lost      int       `json:"lost"`
+	Points    int       `json:"points"`
+	SetsWon   int       `json:"sets_won"`
+	SetsLost  int       `json:"sets_lost"`
+	GamesWon  int       `json:"games_won"`
+	GamesLost int       `json:"games_lost"`
+
+	// TieBreak is what decided the team's place among teams level on
+	// points: head_to_head, game_difference or team_number
+	TieBreak string `json:"tie_break,omitempty"`
+}
+
+// TournamentStandings is a tournament's table and, once decided, winner.
+type TournamentStandings struct {
+	TournamentID uuid.UUID            `json:"tournament_id"`
+	Stage        string               `json:"stage"`
+	Standings    []TournamentStanding `json:"standings"`
+	Winner       *TournamentTeam      `json:"winner,omitempty"`
+	Completed    bool                 `json:"completed"`
+}
@@ -0,0 +1,112 @@
+package rating
+
+import "math"
+
+// ═══════════════════════════════════════════════════════════════════════════
+// GLICKO-2
+// ═══════════════════════════════════════════════════════════════════════════
+// Reference: Mark Glickman, "Example of the Glicko-2 system" (2022).
+// Ratings are kept on the familiar Glicko scale (1500 / 350) and converted
+// to the Glicko-2 scale only for the update.
+// ═══════════════════════════════════════════════════════════════════════════
+
+const (
+	// glickoScale converts between the Glicko and Glicko-2 scales
+	glickoScale = 173.7178
+
+	// tau constrains how much volatility can change per rating period.
+	// Glickman suggests 0.3 to 1.2; club play is noisy, so a middle value.
+	tau = 0.5
+
+	// epsilon is the convergence tolerance of the volatility iteration
+	epsilon = 0.000001
+)
+
+// outcome is one game of a rating period, seen from the rated player.
+type outcome struct {
+	// muDiff is the player's (or their side's) strength minus the
+	// opponent's, on the Glicko-2 scale
+	muDiff float64
+
+	// phi is the opponent's rating deviation on the Glicko-2 scale
+	phi float64
+
+	// score is 1 for a win and 0 for a loss
+	score float64
+}
+
+// update applies one rating period of outcomes to r.
+func update(r Rating, outcomes []outcome) Rating {
+	phi := r.Deviation / glickoScale
+	mu := (r.Rating - DefaultRating) / glickoScale
+
+	if len(outcomes) == 0 {
+		// No games: only the deviation grows
+		phiStar := math.Sqrt(phi*phi + r.Volatility*r.Volatility)
+		return Rating{Rating: r.Rating, Deviation: math.Min(phiStar*glickoScale, DefaultDeviation), Volatility: r.Volatility}
+	}
+
+	// Estimated variance and improvement from the game outcomes
+	var vInv, sum float64
+	for _, o := range outcomes {
+		g := glickoG(o.phi)
+		e := 1 / (1 + math.Exp(-g*o.muDiff))
+		vInv += g * g * e * (1 - e)
+		sum += g * (o.score - e)
+	}
+	v := 1 / vInv
+	delta := v * sum
+
+	sigma := newVolatility(phi, r.Volatility, v, delta)
+
+	phiStar := math.Sqrt(phi*phi + sigma*sigma)
+	newPhi := 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+	newMu := mu + newPhi*newPhi*sum
+
+	return Rating{
+		Rating:     newMu*glickoScale + DefaultRating,
+		Deviation:  math.Min(newPhi*glickoScale, DefaultDeviation),
+		Volatility: sigma,
+	}
+}
+
+// glickoG weights an opponent's result by how certain their rating is.
+func glickoG(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
+
+// newVolatility solves for the new volatility with the Illinois algorithm
+// (step 5 of Glickman's description).
+func newVolatility(phi, sigma, v, delta float64) float64 {
+	a := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + v + ex
+		return ex*(delta*delta-phi*phi-v-ex)/(2*d*d) - (x-a)/(tau*tau)
+	}
+
+	A := a
+	var B float64
+	if delta*delta > phi*phi+v {
+		B = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*tau) < 0 {
+			k++
+		}
+		B = a - k*tau
+	}
+
+	fA, fB := f(A), f(B)
+	for math.Abs(B-A) > epsilon {
+		C := A + (A-B)*fA/(fB-fA)
+		fC := f(C)
+		if fC*fB <= 0 {
+			A, fA = B, fB
+		} else {
+			fA /= 2
+		}
+		B, fB = C, fC
+	}
+	return math.Exp(A / 2)
+}
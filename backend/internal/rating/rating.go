@@ -0,0 +1,216 @@
+package rating
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// PLAYER RATINGS
+// ═══════════════════════════════════════════════════════════════════════════
+// Players get a singles rating and a doubles rating, each updated with
+// Glicko-2 from completed matches in the order they were played. Every
+// match is its own rating period.
+//
+// Doubles: a side's strength is the mean of its players' ratings, so a
+// strong partner raises what is expected of the pair. Each player's rating
+// then moves by their own deviation, so a new player gains or loses more
+// than an established partner for the same result. Australian doubles
+// (1v2) counts towards the doubles rating of all three players.
+//
+// This package is STATELESS with respect to storage: callers feed it
+// matches and persist the results.
+// ═══════════════════════════════════════════════════════════════════════════
+
+// Kind is the kind of rating: singles or doubles.
+type Kind string
+
+const (
+	KindSingles Kind = "singles"
+	KindDoubles Kind = "doubles"
+)
+
+// Starting values for a new player (Glicko scale).
+const (
+	DefaultRating     = 1500.0
+	DefaultDeviation  = 350.0
+	DefaultVolatility = 0.06
+)
+
+// Rating is a Glicko-2 rating on the Glicko scale.
+type Rating struct {
+	Rating     float64
+	Deviation  float64
+	Volatility float64
+}
+
+// Initial returns the rating of a player with no matches.
+func Initial() Rating {
+	return Rating{Rating: DefaultRating, Deviation: DefaultDeviation, Volatility: DefaultVolatility}
+}
+
+// PlayerRating is a player's current rating of one kind.
+type PlayerRating struct {
+	PlayerID uuid.UUID
+	Kind     Kind
+	Rating
+	Matches   int
+	UpdatedAt time.Time // When the last rated match was played
+}
+
+// Match is a completed match with a winner.
+type Match struct {
+	ID       uuid.UUID
+	Kind     Kind
+	PlayedAt time.Time
+	TeamA    []uuid.UUID
+	TeamB    []uuid.UUID
+	WinnerA  bool // Team A won; otherwise Team B did
+}
+
+// Change is how one match moved one player's rating.
+type Change struct {
+	PlayerID uuid.UUID
+	MatchID  uuid.UUID
+	Kind     Kind
+	PlayedAt time.Time
+	Before   Rating
+	After    Rating
+}
+
+// ErrInvalidMatch is returned for a match without players on both sides or
+// with a player on both sides.
+var ErrInvalidMatch = errors.New("match needs distinct players on both sides")
+
+type playerKey struct {
+	id   uuid.UUID
+	kind Kind
+}
+
+// Calculator applies matches to a set of ratings.
+type Calculator struct {
+	ratings map[playerKey]*PlayerRating
+}
+
+// NewCalculator creates a calculator in which every player starts at the
+// initial rating.
+func NewCalculator() *Calculator {
+	return &Calculator{ratings: make(map[playerKey]*PlayerRating)}
+}
+
+// Set seeds a player's rating, e.g. from storage, before applying newer
+// matches.
+func (c *Calculator) Set(r PlayerRating) {
+	c.ratings[playerKey{r.PlayerID, r.Kind}] = &r
+}
+
+// Get returns a player's rating of a kind, or the initial rating.
+func (c *Calculator) Get(playerID uuid.UUID, kind Kind) PlayerRating {
+	if r, ok := c.ratings[playerKey{playerID, kind}]; ok {
+		return *r
+	}
+	return PlayerRating{PlayerID: playerID, Kind: kind, Rating: Initial()}
+}
+
+// Apply rates a match and returns the change for each of its players.
+// Matches must be applied in the order they were played.
+func (c *Calculator) Apply(m Match) ([]Change, error) {
+	if len(m.TeamA) == 0 || len(m.TeamB) == 0 {
+		return nil, ErrInvalidMatch
+	}
+	for _, id := range m.TeamA {
+		for _, other := range m.TeamB {
+			if id == other {
+				return nil, ErrInvalidMatch
+			}
+		}
+	}
+
+	muA, phiA := c.side(m.TeamA, m.Kind)
+	muB, phiB := c.side(m.TeamB, m.Kind)
+
+	scoreA := 0.0
+	if m.WinnerA {
+		scoreA = 1
+	}
+
+	// Rate everyone from the ratings before the match
+	var changes []Change
+	rate := func(team []uuid.UUID, o outcome) {
+		for _, id := range team {
+			before := c.Get(id, m.Kind)
+			changes = append(changes, Change{
+				PlayerID: id,
+				MatchID:  m.ID,
+				Kind:     m.Kind,
+				PlayedAt: m.PlayedAt,
+				Before:   before.Rating,
+				After:    update(before.Rating, []outcome{o}),
+			})
+		}
+	}
+	rate(m.TeamA, outcome{muDiff: muA - muB, phi: phiB, score: scoreA})
+	rate(m.TeamB, outcome{muDiff: muB - muA, phi: phiA, score: 1 - scoreA})
+
+	for _, ch := range changes {
+		r := c.Get(ch.PlayerID, ch.Kind)
+		r.Rating = ch.After
+		r.Matches++
+		r.UpdatedAt = m.PlayedAt
+		c.Set(r)
+	}
+	return changes, nil
+}
+
+// side returns a side's combined strength and deviation on the Glicko-2
+// scale.
+func (c *Calculator) side(team []uuid.UUID, kind Kind) (mu, phi float64) {
+	ratings := make([]Rating, len(team))
+	for i, id := range team {
+		ratings[i] = c.Get(id, kind).Rating
+	}
+	t := Team(ratings...)
+	return (t.Rating - DefaultRating) / glickoScale, t.Deviation / glickoScale
+}
+
+// Team combines the ratings of a side's players: the mean rating, the root
+// mean square deviation and the mean volatility.
+func Team(ratings ...Rating) Rating {
+	if len(ratings) == 0 {
+		return Initial()
+	}
+	var t Rating
+	var variance float64
+	for _, r := range ratings {
+		t.Rating += r.Rating
+		variance += r.Deviation * r.Deviation
+		t.Volatility += r.Volatility
+	}
+	n := float64(len(ratings))
+	t.Rating /= n
+	t.Deviation = math.Sqrt(variance / n)
+	t.Volatility /= n
+	return t
+}
+
+// Ratings returns every rating, by kind and then highest first.
+func (c *Calculator) Ratings() []PlayerRating {
+	result := make([]PlayerRating, 0, len(c.ratings))
+	for _, r := range c.ratings {
+		result = append(result, *r)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Kind != result[j].Kind {
+			return result[i].Kind < result[j].Kind
+		}
+		if result[i].Rating.Rating != result[j].Rating.Rating {
+			return result[i].Rating.Rating > result[j].Rating.Rating
+		}
+		return result[i].PlayerID.String() < result[j].PlayerID.String()
+	})
+	return result
+}
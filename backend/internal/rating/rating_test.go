@@ -0,0 +1,128 @@
+package rating
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func near(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance
+}
+
+// TestUpdateGlickmanExample checks the worked example from Glickman's paper.
+func TestUpdateGlickmanExample(t *testing.T) {
+	player := Rating{Rating: 1500, Deviation: 200, Volatility: 0.06}
+	opponents := []struct {
+		rating, deviation, score float64
+	}{
+		{1400, 30, 1},
+		{1550, 100, 0},
+		{1700, 300, 0},
+	}
+
+	var outcomes []outcome
+	for _, o := range opponents {
+		outcomes = append(outcomes, outcome{
+			muDiff: (player.Rating - o.rating) / glickoScale,
+			phi:    o.deviation / glickoScale,
+			score:  o.score,
+		})
+	}
+
+	got := update(player, outcomes)
+	if !near(got.Rating, 1464.06, 0.01) || !near(got.Deviation, 151.52, 0.01) || !near(got.Volatility, 0.05999, 0.00001) {
+		t.Errorf("expected 1464.06 / 151.52 / 0.05999, got %.2f / %.2f / %.5f", got.Rating, got.Deviation, got.Volatility)
+	}
+}
+
+func TestUpdateWithoutGamesGrowsDeviation(t *testing.T) {
+	got := update(Rating{Rating: 1600, Deviation: 50, Volatility: 0.06}, nil)
+	if got.Rating != 1600 || got.Deviation <= 50 {
+		t.Errorf("expected only the deviation to grow, got %+v", got)
+	}
+}
+
+func TestCalculatorSingles(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+	c := NewCalculator()
+	played := time.Date(2024, 6, 1, 18, 0, 0, 0, time.UTC)
+
+	changes, err := c.Apply(Match{ID: uuid.New(), Kind: KindSingles, PlayedAt: played, TeamA: []uuid.UUID{a}, TeamB: []uuid.UUID{b}, WinnerA: true})
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changes, got %d", len(changes))
+	}
+
+	winner, loser := c.Get(a, KindSingles), c.Get(b, KindSingles)
+	if winner.Rating.Rating <= DefaultRating || loser.Rating.Rating >= DefaultRating {
+		t.Errorf("expected the winner up and the loser down, got %.1f and %.1f", winner.Rating.Rating, loser.Rating.Rating)
+	}
+	if !near(winner.Rating.Rating-DefaultRating, DefaultRating-loser.Rating.Rating, 0.001) {
+		t.Errorf("expected equal players to move symmetrically")
+	}
+	if winner.Matches != 1 || !winner.UpdatedAt.Equal(played) {
+		t.Errorf("unexpected winner record: %+v", winner)
+	}
+	if c.Get(a, KindDoubles).Matches != 0 {
+		t.Error("expected singles not to touch the doubles rating")
+	}
+}
+
+func TestCalculatorDoublesUsesOwnDeviation(t *testing.T) {
+	veteran, rookie, b1, b2 := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	c := NewCalculator()
+	c.Set(PlayerRating{PlayerID: veteran, Kind: KindDoubles, Rating: Rating{Rating: 1500, Deviation: 60, Volatility: 0.06}, Matches: 40})
+
+	_, err := c.Apply(Match{ID: uuid.New(), Kind: KindDoubles, TeamA: []uuid.UUID{veteran, rookie}, TeamB: []uuid.UUID{b1, b2}, WinnerA: true})
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+
+	gainVeteran := c.Get(veteran, KindDoubles).Rating.Rating - 1500
+	gainRookie := c.Get(rookie, KindDoubles).Rating.Rating - DefaultRating
+	if gainVeteran <= 0 || gainRookie <= gainVeteran {
+		t.Errorf("expected the rookie to gain more than the veteran, got %.1f and %.1f", gainRookie, gainVeteran)
+	}
+}
+
+func TestCalculatorRejectsInvalidMatch(t *testing.T) {
+	a := uuid.New()
+	c := NewCalculator()
+	for _, m := range []Match{
+		{Kind: KindSingles, TeamA: []uuid.UUID{a}},
+		{Kind: KindSingles, TeamA: []uuid.UUID{a}, TeamB: []uuid.UUID{a}},
+	} {
+		if _, err := c.Apply(m); !errors.Is(err, ErrInvalidMatch) {
+			t.Errorf("expected ErrInvalidMatch, got %v", err)
+		}
+	}
+}
+
+func TestCalculatorRatingsOrder(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+	c := NewCalculator()
+	if _, err := c.Apply(Match{ID: uuid.New(), Kind: KindSingles, TeamA: []uuid.UUID{a}, TeamB: []uuid.UUID{b}, WinnerA: false}); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+
+	ratings := c.Ratings()
+	if len(ratings) != 2 || ratings[0].PlayerID != b {
+		t.Errorf("expected the winner first, got %+v", ratings)
+	}
+}
+
+func TestTeam(t *testing.T) {
+	team := Team(Rating{Rating: 1600, Deviation: 30, Volatility: 0.06}, Rating{Rating: 1400, Deviation: 40, Volatility: 0.06})
+	if team.Rating != 1500 || !near(team.Deviation, math.Sqrt(1250), 1e-9) {
+		t.Errorf("expected 1500 with an RMS deviation, got %+v", team)
+	}
+	if Team() != Initial() {
+		t.Error("expected an empty side to have the initial rating")
+	}
+}
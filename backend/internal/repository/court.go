@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+)
+
+// CourtRepository handles court database operations.
+type CourtRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewCourtRepository creates a new court repository.
+func NewCourtRepository(pool *pgxpool.Pool) *CourtRepository {
+	return &CourtRepository{pool: pool}
+}
+
+// courtColumns lists the columns read by scanCourt.
+const courtColumns = `id, venue_id, name, surface, indoor, lights, active, created_at`
+
+// scanCourt reads a row selected with courtColumns.
+func scanCourt(row pgx.Row) (*model.Court, error) {
+	c := &model.Court{}
+	err := row.Scan(&c.ID, &c.VenueID, &c.Name, &c.Surface, &c.Indoor, &c.Lights, &c.Active, &c.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Create inserts a new court.
+func (r *CourtRepository) Create(ctx context.Context, court *model.Court) error {
+	query := `
+		INSERT INTO courts (id, venue_id, name, surface, indoor, lights, active)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING created_at
+	`
+	if court.ID == uuid.Nil {
+		court.ID = uuid.New()
+	}
+
+	err := r.pool.QueryRow(ctx, query,
+		court.ID, court.VenueID, court.Name, court.Surface, court.Indoor, court.Lights, court.Active,
+	).Scan(&court.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create court: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a court by ID.
+func (r *CourtRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Court, error) {
+	query := `SELECT ` + courtColumns + ` FROM courts WHERE id = $1`
+
+	court, err := scanCourt(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get court: %w", err)
+	}
+	return court, nil
+}
+
+// ListByVenue retrieves the courts of a venue.
+func (r *CourtRepository) ListByVenue(ctx context.Context, venueID uuid.UUID, activeOnly bool) ([]model.Court, error) {
+	query := `SELECT ` + courtColumns + ` FROM courts WHERE venue_id = $1`
+	if activeOnly {
+		query += ` AND active = true`
+	}
+	query += ` ORDER BY name ASC`
+
+	rows, err := r.pool.Query(ctx, query, venueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list courts: %w", err)
+	}
+	defer rows.Close()
+
+	var courts []model.Court
+	for rows.Next() {
+		c, err := scanCourt(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan court: %w", err)
+		}
+		courts = append(courts, *c)
+	}
+
+	if courts == nil {
+		courts = []model.Court{}
+	}
+	return courts, nil
+}
+
+// Update modifies an existing court.
+func (r *CourtRepository) Update(ctx context.Context, court *model.Court) error {
+	query := `
+		UPDATE courts SET name = $2, surface = $3, indoor = $4, lights = $5, active = $6
+		WHERE id = $1
+	`
+	result, err := r.pool.Exec(ctx, query,
+		court.ID, court.Name, court.Surface, court.Indoor, court.Lights, court.Active,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update court: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Delete removes a court. Matches played on it keep their venue but lose
+// the court reference.
+func (r *CourtRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM courts WHERE id = $1`
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete court: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
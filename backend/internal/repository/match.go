@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
 )
@@ -18,6 +19,24 @@ type MatchRepository struct {
 	pool *pgxpool.Pool
 }
 
+// matchColumns lists the columns read by scanMatch.
+const matchColumns = `id, venue_id, match_type, mode, servers, best_of, court_id, status, tie_breaks, scheduled_at, started_at, ended_at, created_at,
+	winner_team, COALESCE(score, ''), result_only`
+
+// scanMatch reads a row selected with matchColumns.
+func scanMatch(row pgx.Row) (*model.Match, error) {
+	m := &model.Match{}
+	err := row.Scan(
+		&m.ID, &m.VenueID, &m.MatchType, &m.Mode, &m.Servers, &m.BestOf, &m.CourtID,
+		&m.Status, &m.TieBreaks, &m.ScheduledAt, &m.StartedAt, &m.EndedAt, &m.CreatedAt,
+		&m.WinnerTeam, &m.Score, &m.ResultOnly,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // NewMatchRepository creates a new match repository.
 func NewMatchRepository(pool *pgxpool.Pool) *MatchRepository {
 	return &MatchRepository{pool: pool}
@@ -37,11 +56,20 @@ func (r *MatchRepository) Create(ctx context.Context, match *model.Match, player
 	}
 
 	matchQuery := `
-		INSERT INTO matches (id, venue_id, match_type, started_at)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO matches (id, venue_id, match_type, mode, servers, best_of, court_id, status, scheduled_at, started_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 		RETURNING created_at
 	`
-	err = tx.QueryRow(ctx, matchQuery, match.ID, match.VenueID, match.MatchType, match.StartedAt).Scan(&match.CreatedAt)
+	if match.Mode == "" {
+		match.Mode = model.MatchModeStandard
+	}
+	if match.Status == "" {
+		match.Status = model.MatchStatusInProgress
+	}
+	err = tx.QueryRow(ctx, matchQuery,
+		match.ID, match.VenueID, match.MatchType, match.Mode, match.Servers, match.BestOf,
+		match.CourtID, match.Status, match.ScheduledAt, match.StartedAt,
+	).Scan(&match.CreatedAt)
 	if err != nil {
 		return fmt.Errorf("failed to create match: %w", err)
 	}
@@ -64,17 +92,74 @@ func (r *MatchRepository) Create(ctx context.Context, match *model.Match, player
 	return nil
 }
 
+// ImportedMatch is a finished match with its players and points, as
+// inserted by Import. Result-only matches have no points.
+type ImportedMatch struct {
+	Match   *model.Match
+	Players []model.MatchPlayer
+	Events  []model.PointEvent
+}
+
+// Import inserts finished matches with their players and points in a single
+// transaction, so either every match is imported or none is.
+func (r *MatchRepository) Import(ctx context.Context, matches []ImportedMatch) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	matchQuery := `
+		INSERT INTO matches (id, venue_id, match_type, mode, servers, best_of, court_id, status, tie_breaks,
+			started_at, ended_at, winner_team, score, result_only)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		RETURNING created_at
+	`
+	playerQuery := `
+		INSERT INTO match_players (match_id, player_id, team)
+		VALUES ($1, $2, $3)
+	`
+	eventColumns := []string{"id", "match_id", "seq", "timestamp", "server_player_id", "serve_type", "point_winner_team"}
+
+	for _, im := range matches {
+		m := im.Match
+		if m.ID == uuid.Nil {
+			m.ID = uuid.New()
+		}
+
+		err := tx.QueryRow(ctx, matchQuery,
+			m.ID, m.VenueID, m.MatchType, m.Mode, m.Servers, m.BestOf, m.CourtID, m.Status, m.TieBreaks,
+			m.StartedAt, m.EndedAt, m.WinnerTeam, m.Score, m.ResultOnly,
+		).Scan(&m.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to import match: %w", err)
+		}
+
+		for _, mp := range im.Players {
+			if _, err := tx.Exec(ctx, playerQuery, m.ID, mp.PlayerID, mp.Team); err != nil {
+				return fmt.Errorf("failed to add player to match: %w", err)
+			}
+		}
+
+		rows := make([][]interface{}, len(im.Events))
+		for i, e := range im.Events {
+			rows[i] = []interface{}{e.ID, m.ID, e.Seq, e.Timestamp, e.ServerPlayerID, string(e.ServeType), string(e.PointWinnerTeam)}
+		}
+		if _, err := tx.CopyFrom(ctx, pgx.Identifier{"point_events"}, eventColumns, pgx.CopyFromRows(rows)); err != nil {
+			return fmt.Errorf("failed to import events: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
 // GetByID retrieves a match by ID.
 func (r *MatchRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Match, error) {
-	query := `
-		SELECT id, venue_id, match_type, started_at, ended_at, created_at
-		FROM matches WHERE id = $1
-	`
-	match := &model.Match{}
-	err := r.pool.QueryRow(ctx, query, id).Scan(
-		&match.ID, &match.VenueID, &match.MatchType,
-		&match.StartedAt, &match.EndedAt, &match.CreatedAt,
-	)
+	query := `SELECT ` + matchColumns + ` FROM matches WHERE id = $1`
+	match, err := scanMatch(r.pool.QueryRow(ctx, query, id))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, ErrNotFound
@@ -112,19 +197,165 @@ func (r *MatchRepository) GetMatchPlayers(ctx context.Context, matchID uuid.UUID
 	return players, nil
 }
 
-// Complete marks a match as completed.
-func (r *MatchRepository) Complete(ctx context.Context, matchID uuid.UUID, endedAt time.Time) error {
-	query := `UPDATE matches SET ended_at = $2 WHERE id = $1 AND ended_at IS NULL`
-	result, err := r.pool.Exec(ctx, query, matchID, endedAt)
+// StatusChange moves a match from one status to another at a time.
+type StatusChange struct {
+	MatchID  uuid.UUID
+	From, To model.MatchStatus
+	At       time.Time
+	Reason   string // Why a match is suspended
+
+	// Result, if set, replaces the match's winner and scoreline
+	Result *MatchResult
+}
+
+// MatchResult is the winner and scoreline of a match.
+type MatchResult struct {
+	Winner *model.Team
+	Score  string
+}
+
+// ChangeStatus moves a match from one status to another, recording when it
+// started or ended, storing its result if given and opening or closing
+// suspensions as needed. Returns ErrStatusChanged if the match is no longer
+// in the expected status.
+func (r *MatchRepository) ChangeStatus(ctx context.Context, change StatusChange) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := changeStatus(ctx, tx, change); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// changeStatus applies a status change within a transaction.
+func changeStatus(ctx context.Context, tx pgx.Tx, change StatusChange) error {
+	var startedAt, endedAt *time.Time
+	if change.From == model.MatchStatusScheduled && change.To == model.MatchStatusInProgress {
+		startedAt = &change.At
+	}
+	if change.To == model.MatchStatusCompleted || change.To == model.MatchStatusAbandoned {
+		endedAt = &change.At
+	}
+
+	query := `
+		UPDATE matches
+		SET status = $3, started_at = COALESCE($4, started_at), ended_at = COALESCE($5, ended_at)
+		WHERE id = $1 AND status = $2
+	`
+	result, err := tx.Exec(ctx, query, change.MatchID, change.From, change.To, startedAt, endedAt)
 	if err != nil {
-		return fmt.Errorf("failed to complete match: %w", err)
+		return fmt.Errorf("failed to update match status: %w", err)
 	}
 	if result.RowsAffected() == 0 {
-		return ErrNotFound
+		return ErrStatusChanged
+	}
+
+	if change.Result != nil {
+		_, err = tx.Exec(ctx,
+			`UPDATE matches SET winner_team = $2, score = $3 WHERE id = $1`,
+			change.MatchID, change.Result.Winner, change.Result.Score,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to store match result: %w", err)
+		}
+	}
+
+	if change.From == model.MatchStatusSuspended {
+		_, err = tx.Exec(ctx,
+			`UPDATE match_suspensions SET resumed_at = $2 WHERE match_id = $1 AND resumed_at IS NULL`,
+			change.MatchID, change.At,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to close suspension: %w", err)
+		}
+	}
+
+	if change.To == model.MatchStatusSuspended {
+		_, err = tx.Exec(ctx,
+			`INSERT INTO match_suspensions (match_id, reason, suspended_at) VALUES ($1, $2, $3)`,
+			change.MatchID, change.Reason, change.At,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record suspension: %w", err)
+		}
 	}
 	return nil
 }
 
+// ListSuspensions retrieves the suspensions of a match in order.
+func (r *MatchRepository) ListSuspensions(ctx context.Context, matchID uuid.UUID) ([]model.MatchSuspension, error) {
+	query := `
+		SELECT id, match_id, reason, suspended_at, resumed_at
+		FROM match_suspensions
+		WHERE match_id = $1
+		ORDER BY suspended_at ASC
+	`
+	rows, err := r.pool.Query(ctx, query, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list suspensions: %w", err)
+	}
+	defer rows.Close()
+
+	var suspensions []model.MatchSuspension
+	for rows.Next() {
+		var sp model.MatchSuspension
+		if err := rows.Scan(&sp.ID, &sp.MatchID, &sp.Reason, &sp.SuspendedAt, &sp.ResumedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan suspension: %w", err)
+		}
+		suspensions = append(suspensions, sp)
+	}
+
+	if suspensions == nil {
+		suspensions = []model.MatchSuspension{}
+	}
+	return suspensions, nil
+}
+
+// ListSuspensionsForMatches retrieves the suspensions of every match
+// matching a filter in one query, in order, keyed by match ID.
+func (r *MatchRepository) ListSuspensionsForMatches(ctx context.Context, filter MatchFilter) (map[uuid.UUID][]model.MatchSuspension, error) {
+	var args []interface{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	where := ""
+	if conds := matchFilterConditions(filter, arg); len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	query := `
+		SELECT id, match_id, reason, suspended_at, resumed_at
+		FROM match_suspensions
+		WHERE match_id IN (SELECT m.id FROM matches m ` + where + `)
+		ORDER BY match_id, suspended_at ASC
+	`
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list suspensions: %w", err)
+	}
+	defer rows.Close()
+
+	suspensions := make(map[uuid.UUID][]model.MatchSuspension)
+	for rows.Next() {
+		var sp model.MatchSuspension
+		if err := rows.Scan(&sp.ID, &sp.MatchID, &sp.Reason, &sp.SuspendedAt, &sp.ResumedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan suspension: %w", err)
+		}
+		suspensions[sp.MatchID] = append(suspensions[sp.MatchID], sp)
+	}
+	return suspensions, rows.Err()
+}
+
 // Delete removes a match and all related data.
 func (r *MatchRepository) Delete(ctx context.Context, matchID uuid.UUID) error {
 	query := `DELETE FROM matches WHERE id = $1`
@@ -138,27 +369,266 @@ func (r *MatchRepository) Delete(ctx context.Context, matchID uuid.UUID) error {
 	return nil
 }
 
-// List retrieves all matches with optional filtering.
-func (r *MatchRepository) List(ctx context.Context, limit int) ([]model.Match, error) {
+// MatchFilter narrows the match history. Zero fields are not filtered on.
+// PartnerID and OpponentID are relative to PlayerID.
+type MatchFilter struct {
+	VenueID    *uuid.UUID
+	PlayerID   *uuid.UUID
+	PartnerID  *uuid.UUID
+	OpponentID *uuid.UUID
+	MatchType  model.MatchType
+	Mode       model.MatchMode
+	Status     model.MatchStatus
+	Surface    *model.Surface // Court surface, falling back to the venue's
+	From       *time.Time
+	To         *time.Time
+}
+
+// MatchCursor is the position after the last match of a history page.
+type MatchCursor struct {
+	StartedAt time.Time
+	ID        uuid.UUID
+}
+
+// ListHistory retrieves up to limit matches matching a filter, newest first,
+// starting after the cursor if one is given.
+func (r *MatchRepository) ListHistory(ctx context.Context, filter MatchFilter, after *MatchCursor, limit int) ([]model.Match, error) {
+	var args []interface{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	conds := matchFilterConditions(filter, arg)
+	if after != nil {
+		conds = append(conds, "(started_at, id) < ("+arg(after.StartedAt)+", "+arg(after.ID)+")")
+	}
+
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	query := `
+		SELECT ` + matchColumns + `
+		FROM matches m
+		` + where + `
+		ORDER BY started_at DESC, id DESC
+		LIMIT ` + arg(limit)
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list match history: %w", err)
+	}
+	defer rows.Close()
+
+	var matches []model.Match
+	for rows.Next() {
+		m, err := scanMatch(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan match: %w", err)
+		}
+		matches = append(matches, *m)
+	}
+
+	if matches == nil {
+		matches = []model.Match{}
+	}
+	return matches, nil
+}
+
+// matchFilterConditions builds the WHERE conditions on matches m for a
+// filter. arg adds a query argument and returns its placeholder.
+func matchFilterConditions(filter MatchFilter, arg func(interface{}) string) []string {
+	var conds []string
+
+	if filter.VenueID != nil {
+		conds = append(conds, "venue_id = "+arg(*filter.VenueID))
+	}
+	if filter.PlayerID != nil {
+		player := arg(*filter.PlayerID)
+		conds = append(conds, `EXISTS (
+			SELECT 1 FROM match_players mp WHERE mp.match_id = m.id AND mp.player_id = `+player+`)`)
+		if filter.PartnerID != nil {
+			conds = append(conds, `EXISTS (
+				SELECT 1 FROM match_players p1
+				JOIN match_players p2 ON p2.match_id = p1.match_id AND p2.team = p1.team
+				WHERE p1.match_id = m.id AND p1.player_id = `+player+` AND p2.player_id = `+arg(*filter.PartnerID)+`)`)
+		}
+		if filter.OpponentID != nil {
+			conds = append(conds, `EXISTS (
+				SELECT 1 FROM match_players p1
+				JOIN match_players p2 ON p2.match_id = p1.match_id AND p2.team <> p1.team
+				WHERE p1.match_id = m.id AND p1.player_id = `+player+` AND p2.player_id = `+arg(*filter.OpponentID)+`)`)
+		}
+	}
+	if filter.MatchType != "" {
+		conds = append(conds, "match_type = "+arg(filter.MatchType))
+	}
+	if filter.Mode != "" {
+		conds = append(conds, "mode = "+arg(filter.Mode))
+	}
+	if filter.Status != "" {
+		conds = append(conds, "status = "+arg(filter.Status))
+	}
+	if filter.Surface != nil {
+		conds = append(conds, `COALESCE(
+			(SELECT c.surface FROM courts c WHERE c.id = m.court_id),
+			(SELECT v.surface FROM venues v WHERE v.id = m.venue_id)) = `+arg(*filter.Surface))
+	}
+	if filter.From != nil {
+		conds = append(conds, "started_at >= "+arg(*filter.From))
+	}
+	if filter.To != nil {
+		conds = append(conds, "started_at < "+arg(*filter.To))
+	}
+	return conds
+}
+
+// streamBatch is the number of matches StreamMatches reads at a time.
+const streamBatch = 100
+
+// StreamMatches calls fn for each match matching a filter, newest first,
+// with its players and point events. Matches are read in batches of
+// streamBatch, with the players and events of a batch fetched together, so
+// an export of any size only holds one batch in memory and no connection is
+// held while fn runs. Iteration stops at the first error fn returns.
+func (r *MatchRepository) StreamMatches(ctx context.Context, filter MatchFilter, fn func(model.Match, []model.MatchPlayer, []model.PointEvent) error) error {
+	var after *MatchCursor
+	for {
+		matches, err := r.ListHistory(ctx, filter, after, streamBatch)
+		if err != nil {
+			return fmt.Errorf("failed to stream matches: %w", err)
+		}
+		if len(matches) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, len(matches))
+		for i, m := range matches {
+			ids[i] = m.ID
+		}
+		players, err := r.GetPlayersForMatches(ctx, ids)
+		if err != nil {
+			return err
+		}
+		events, err := r.getEventsForMatches(ctx, ids)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range matches {
+			matchEvents := events[m.ID]
+			if matchEvents == nil {
+				matchEvents = []model.PointEvent{}
+			}
+			if err := fn(m, players[m.ID], matchEvents); err != nil {
+				return err
+			}
+		}
+
+		if len(matches) < streamBatch {
+			return nil
+		}
+		last := matches[len(matches)-1]
+		after = &MatchCursor{StartedAt: last.StartedAt, ID: last.ID}
+	}
+}
+
+// SetResult stores the winner and scoreline of a match.
+func (r *MatchRepository) SetResult(ctx context.Context, matchID uuid.UUID, winner *model.Team, score string) error {
+	query := `UPDATE matches SET winner_team = $2, score = $3 WHERE id = $1`
+	result, err := r.pool.Exec(ctx, query, matchID, winner, score)
+	if err != nil {
+		return fmt.Errorf("failed to store match result: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ListCompletedWithoutResult retrieves completed matches with no stored
+// result, other than result-only matches, in the order they ended.
+func (r *MatchRepository) ListCompletedWithoutResult(ctx context.Context) ([]model.Match, error) {
+	query := `
+		SELECT ` + matchColumns + `
+		FROM matches
+		WHERE status = 'completed' AND score IS NULL AND NOT result_only
+		ORDER BY ended_at ASC, id ASC
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list matches without a result: %w", err)
+	}
+	defer rows.Close()
+
+	var matches []model.Match
+	for rows.Next() {
+		m, err := scanMatch(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan match: %w", err)
+		}
+		matches = append(matches, *m)
+	}
+	return matches, rows.Err()
+}
+
+// ListInProgressByVenue retrieves matches at a venue that are being played
+// or are suspended.
+func (r *MatchRepository) ListInProgressByVenue(ctx context.Context, venueID uuid.UUID) ([]model.Match, error) {
+	query := `
+		SELECT ` + matchColumns + `
+		FROM matches
+		WHERE venue_id = $1 AND status IN ('in_progress', 'suspended')
+		ORDER BY started_at ASC
+	`
+	rows, err := r.pool.Query(ctx, query, venueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list in-progress matches: %w", err)
+	}
+	defer rows.Close()
+
+	var matches []model.Match
+	for rows.Next() {
+		m, err := scanMatch(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan match: %w", err)
+		}
+		matches = append(matches, *m)
+	}
+
+	if matches == nil {
+		matches = []model.Match{}
+	}
+	return matches, nil
+}
+
+// ListSchedule retrieves matches planned or played between from and to that
+// have not ended, optionally limited to one venue.
+func (r *MatchRepository) ListSchedule(ctx context.Context, from, to time.Time, venueID *uuid.UUID) ([]model.Match, error) {
 	query := `
-		SELECT id, venue_id, match_type, started_at, ended_at, created_at
+		SELECT ` + matchColumns + `
 		FROM matches
-		ORDER BY started_at DESC
-		LIMIT $1
+		WHERE status IN ('scheduled', 'in_progress', 'suspended')
+		  AND COALESCE(scheduled_at, started_at) >= $1
+		  AND COALESCE(scheduled_at, started_at) < $2
+		  AND ($3::uuid IS NULL OR venue_id = $3)
+		ORDER BY COALESCE(scheduled_at, started_at) ASC
 	`
-	rows, err := r.pool.Query(ctx, query, limit)
+	rows, err := r.pool.Query(ctx, query, from, to, venueID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list matches: %w", err)
+		return nil, fmt.Errorf("failed to list schedule: %w", err)
 	}
 	defer rows.Close()
 
 	var matches []model.Match
 	for rows.Next() {
-		var m model.Match
-		if err := rows.Scan(&m.ID, &m.VenueID, &m.MatchType, &m.StartedAt, &m.EndedAt, &m.CreatedAt); err != nil {
+		m, err := scanMatch(rows)
+		if err != nil {
 			return nil, fmt.Errorf("failed to scan match: %w", err)
 		}
-		matches = append(matches, m)
+		matches = append(matches, *m)
 	}
 
 	if matches == nil {
@@ -167,47 +637,195 @@ func (r *MatchRepository) List(ctx context.Context, limit int) ([]model.Match, e
 	return matches, nil
 }
 
-// InsertEvents adds point events to a match (idempotent - ignores duplicates).
-func (r *MatchRepository) InsertEvents(ctx context.Context, events []model.PointEvent) (int, error) {
+// MatchLineup is who played on each side of a match.
+type MatchLineup struct {
+	MatchID   uuid.UUID
+	StartedAt time.Time
+	Players   []model.MatchPlayer
+}
+
+// ListDoublesLineups retrieves the lineups of doubles and 1v2 matches that
+// started in [from, to) and have been played, oldest first.
+func (r *MatchRepository) ListDoublesLineups(ctx context.Context, from, to time.Time) ([]MatchLineup, error) {
+	query := `
+		SELECT m.id, m.started_at, mp.player_id, mp.team
+		FROM matches m
+		JOIN match_players mp ON mp.match_id = m.id
+		WHERE m.match_type IN ('doubles', '1v2')
+		  AND m.status <> 'scheduled'
+		  AND m.started_at >= $1
+		  AND m.started_at < $2
+		ORDER BY m.started_at ASC, m.id ASC, mp.team ASC, mp.player_id ASC
+	`
+	rows, err := r.pool.Query(ctx, query, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list doubles lineups: %w", err)
+	}
+	defer rows.Close()
+
+	lineups := []MatchLineup{}
+	for rows.Next() {
+		var l MatchLineup
+		var mp model.MatchPlayer
+		if err := rows.Scan(&l.MatchID, &l.StartedAt, &mp.PlayerID, &mp.Team); err != nil {
+			return nil, fmt.Errorf("failed to scan lineup: %w", err)
+		}
+		mp.MatchID = l.MatchID
+		if n := len(lineups); n > 0 && lineups[n-1].MatchID == l.MatchID {
+			lineups[n-1].Players = append(lineups[n-1].Players, mp)
+			continue
+		}
+		l.Players = []model.MatchPlayer{mp}
+		lineups = append(lineups, l)
+	}
+	return lineups, rows.Err()
+}
+
+// GetPlayersForMatches retrieves the players of several matches, keyed by
+// match ID.
+func (r *MatchRepository) GetPlayersForMatches(ctx context.Context, matchIDs []uuid.UUID) (map[uuid.UUID][]model.MatchPlayer, error) {
+	players := make(map[uuid.UUID][]model.MatchPlayer, len(matchIDs))
+	if len(matchIDs) == 0 {
+		return players, nil
+	}
+
+	query := `
+		SELECT match_id, player_id, team
+		FROM match_players
+		WHERE match_id = ANY($1)
+	`
+	rows, err := r.pool.Query(ctx, query, matchIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match players: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var mp model.MatchPlayer
+		if err := rows.Scan(&mp.MatchID, &mp.PlayerID, &mp.Team); err != nil {
+			return nil, fmt.Errorf("failed to scan match player: %w", err)
+		}
+		players[mp.MatchID] = append(players[mp.MatchID], mp)
+	}
+	return players, nil
+}
+
+// eventQuerier runs event queries on the pool or inside a transaction.
+type eventQuerier interface {
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
+// AddEvents adds point events to a match in one transaction. The match row
+// is locked so concurrent submissions are numbered one after the other:
+// prepare receives the stored events and the voided ones (ID and sequence
+// number only) and returns the events to insert. Returns the stored and
+// voided events and how many were inserted (duplicates are ignored), or
+// ErrSequenceTaken if another event holds a sequence number.
+func (r *MatchRepository) AddEvents(ctx context.Context, matchID uuid.UUID, prepare func(stored, voided []model.PointEvent) ([]model.PointEvent, error)) (stored, voided []model.PointEvent, inserted int, err error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var id uuid.UUID
+	if err := tx.QueryRow(ctx, `SELECT id FROM matches WHERE id = $1 FOR UPDATE`, matchID).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, 0, ErrNotFound
+		}
+		return nil, nil, 0, fmt.Errorf("failed to lock match: %w", err)
+	}
+
+	if stored, err = getEvents(ctx, tx, matchID); err != nil {
+		return nil, nil, 0, err
+	}
+	if voided, err = getVoidedEvents(ctx, tx, matchID); err != nil {
+		return nil, nil, 0, err
+	}
+	events, err := prepare(stored, voided)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if inserted, err = insertEvents(ctx, tx, events); err != nil {
+		return nil, nil, 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return stored, voided, inserted, nil
+}
+
+// getVoidedEvents retrieves the ID and sequence number of a match's voided
+// points.
+func getVoidedEvents(ctx context.Context, tx pgx.Tx, matchID uuid.UUID) ([]model.PointEvent, error) {
+	rows, err := tx.Query(ctx, `SELECT event_id, seq FROM voided_points WHERE match_id = $1 ORDER BY seq ASC`, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get voided events: %w", err)
+	}
+	defer rows.Close()
+
+	var voided []model.PointEvent
+	for rows.Next() {
+		e := model.PointEvent{MatchID: matchID}
+		if err := rows.Scan(&e.ID, &e.Seq); err != nil {
+			return nil, fmt.Errorf("failed to scan voided event: %w", err)
+		}
+		voided = append(voided, e)
+	}
+	return voided, rows.Err()
+}
+
+// insertEvents adds point events (idempotent - ignores duplicates).
+func insertEvents(ctx context.Context, tx pgx.Tx, events []model.PointEvent) (int, error) {
 	if len(events) == 0 {
 		return 0, nil
 	}
 
 	// Build bulk insert query with ON CONFLICT DO NOTHING for idempotency
 	valueStrings := make([]string, 0, len(events))
-	valueArgs := make([]interface{}, 0, len(events)*6)
+	valueArgs := make([]interface{}, 0, len(events)*7)
 
 	for i, e := range events {
 		valueStrings = append(valueStrings, fmt.Sprintf(
-			"($%d, $%d, $%d, $%d, $%d, $%d)",
-			i*6+1, i*6+2, i*6+3, i*6+4, i*6+5, i*6+6,
+			"($%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*7+1, i*7+2, i*7+3, i*7+4, i*7+5, i*7+6, i*7+7,
 		))
-		valueArgs = append(valueArgs, e.ID, e.MatchID, e.Timestamp, e.ServerPlayerID, e.ServeType, e.PointWinnerTeam)
+		valueArgs = append(valueArgs, e.ID, e.MatchID, e.Seq, e.Timestamp, e.ServerPlayerID, e.ServeType, e.PointWinnerTeam)
 	}
 
 	query := fmt.Sprintf(`
-		INSERT INTO point_events (id, match_id, timestamp, server_player_id, serve_type, point_winner_team)
+		INSERT INTO point_events (id, match_id, seq, timestamp, server_player_id, serve_type, point_winner_team)
 		VALUES %s
 		ON CONFLICT (id) DO NOTHING
 	`, strings.Join(valueStrings, ","))
 
-	result, err := r.pool.Exec(ctx, query, valueArgs...)
+	result, err := tx.Exec(ctx, query, valueArgs...)
 	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "point_events_match_seq_key" {
+			return 0, ErrSequenceTaken
+		}
 		return 0, fmt.Errorf("failed to insert events: %w", err)
 	}
 
 	return int(result.RowsAffected()), nil
 }
 
-// GetEvents retrieves all events for a match.
+// GetEvents retrieves all events for a match in sequence order.
 func (r *MatchRepository) GetEvents(ctx context.Context, matchID uuid.UUID) ([]model.PointEvent, error) {
+	return getEvents(ctx, r.pool, matchID)
+}
+
+// getEvents retrieves all events for a match in sequence order.
+func getEvents(ctx context.Context, q eventQuerier, matchID uuid.UUID) ([]model.PointEvent, error) {
 	query := `
-		SELECT id, match_id, timestamp, server_player_id, serve_type, point_winner_team
+		SELECT id, match_id, seq, timestamp, server_player_id, serve_type, point_winner_team
 		FROM point_events
 		WHERE match_id = $1
-		ORDER BY timestamp ASC
+		ORDER BY seq ASC
 	`
-	rows, err := r.pool.Query(ctx, query, matchID)
+	rows, err := q.Query(ctx, query, matchID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get events: %w", err)
 	}
@@ -216,7 +834,7 @@ func (r *MatchRepository) GetEvents(ctx context.Context, matchID uuid.UUID) ([]m
 	var events []model.PointEvent
 	for rows.Next() {
 		var e model.PointEvent
-		if err := rows.Scan(&e.ID, &e.MatchID, &e.Timestamp, &e.ServerPlayerID, &e.ServeType, &e.PointWinnerTeam); err != nil {
+		if err := rows.Scan(&e.ID, &e.MatchID, &e.Seq, &e.Timestamp, &e.ServerPlayerID, &e.ServeType, &e.PointWinnerTeam); err != nil {
 			return nil, fmt.Errorf("failed to scan event: %w", err)
 		}
 		events = append(events, e)
@@ -227,3 +845,204 @@ func (r *MatchRepository) GetEvents(ctx context.Context, matchID uuid.UUID) ([]m
 	}
 	return events, nil
 }
+
+// getEventsForMatches retrieves the point events of several matches in
+// sequence order, keyed by match ID.
+func (r *MatchRepository) getEventsForMatches(ctx context.Context, matchIDs []uuid.UUID) (map[uuid.UUID][]model.PointEvent, error) {
+	query := `
+		SELECT id, match_id, seq, timestamp, server_player_id, serve_type, point_winner_team
+		FROM point_events
+		WHERE match_id = ANY($1)
+		ORDER BY match_id, seq ASC
+	`
+	rows, err := r.pool.Query(ctx, query, matchIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make(map[uuid.UUID][]model.PointEvent, len(matchIDs))
+	for rows.Next() {
+		var e model.PointEvent
+		if err := rows.Scan(&e.ID, &e.MatchID, &e.Seq, &e.Timestamp, &e.ServerPlayerID, &e.ServeType, &e.PointWinnerTeam); err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+		events[e.MatchID] = append(events[e.MatchID], e)
+	}
+	return events, rows.Err()
+}
+
+// DeleteEvent removes a single point event from a match. Its sequence number
+// stays taken (see voided_points); later points keep theirs.
+func (r *MatchRepository) DeleteEvent(ctx context.Context, matchID, eventID uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var seq int
+	err = tx.QueryRow(ctx,
+		`DELETE FROM point_events WHERE id = $1 AND match_id = $2 RETURNING seq`,
+		eventID, matchID,
+	).Scan(&seq)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("failed to delete event: %w", err)
+	}
+
+	_, err = tx.Exec(ctx,
+		`INSERT INTO voided_points (match_id, seq, event_id) VALUES ($1, $2, $3)`,
+		matchID, seq, eventID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record voided event: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// UpdateEvent replaces the outcome of a recorded point, keeping its
+// position in the match.
+func (r *MatchRepository) UpdateEvent(ctx context.Context, event model.PointEvent) error {
+	query := `
+		UPDATE point_events
+		SET server_player_id = $3, serve_type = $4, point_winner_team = $5
+		WHERE id = $1 AND match_id = $2
+	`
+	result, err := r.pool.Exec(ctx, query, event.ID, event.MatchID, event.ServerPlayerID, event.ServeType, event.PointWinnerTeam)
+	if err != nil {
+		return fmt.Errorf("failed to update event: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// CreateConflict records a scoring conflict between devices.
+func (r *MatchRepository) CreateConflict(ctx context.Context, conflict *model.PointConflict) error {
+	query := `
+		INSERT INTO point_conflicts (
+			id, match_id, device_id, position, canonical_event_id,
+			proposed_event_id, proposed_timestamp, proposed_server_player_id,
+			proposed_serve_type, proposed_winner_team
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING created_at
+	`
+	if conflict.ID == uuid.Nil {
+		conflict.ID = uuid.New()
+	}
+
+	p := conflict.Proposed
+	err := r.pool.QueryRow(ctx, query,
+		conflict.ID, conflict.MatchID, conflict.DeviceID, conflict.Position, conflict.CanonicalEventID,
+		p.ID, p.Timestamp, p.ServerPlayerID, p.ServeType, p.PointWinnerTeam,
+	).Scan(&conflict.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create conflict: %w", err)
+	}
+	return nil
+}
+
+// GetConflict retrieves a scoring conflict by ID.
+func (r *MatchRepository) GetConflict(ctx context.Context, matchID, conflictID uuid.UUID) (*model.PointConflict, error) {
+	query := conflictSelect + ` WHERE match_id = $1 AND id = $2`
+
+	c, err := scanConflict(r.pool.QueryRow(ctx, query, matchID, conflictID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get conflict: %w", err)
+	}
+	return c, nil
+}
+
+// GetOpenConflictByProposed retrieves the unresolved conflict raised for a
+// proposed point, so a resubmitted point doesn't raise it again.
+func (r *MatchRepository) GetOpenConflictByProposed(ctx context.Context, matchID, proposedEventID uuid.UUID) (*model.PointConflict, error) {
+	query := conflictSelect + ` WHERE match_id = $1 AND proposed_event_id = $2 AND resolved_at IS NULL
+		ORDER BY created_at ASC LIMIT 1`
+
+	c, err := scanConflict(r.pool.QueryRow(ctx, query, matchID, proposedEventID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get conflict: %w", err)
+	}
+	return c, nil
+}
+
+// ListOpenConflicts retrieves unresolved scoring conflicts for a match.
+func (r *MatchRepository) ListOpenConflicts(ctx context.Context, matchID uuid.UUID) ([]model.PointConflict, error) {
+	query := conflictSelect + ` WHERE match_id = $1 AND resolved_at IS NULL ORDER BY position ASC, created_at ASC`
+
+	rows, err := r.pool.Query(ctx, query, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list conflicts: %w", err)
+	}
+	defer rows.Close()
+
+	var conflicts []model.PointConflict
+	for rows.Next() {
+		c, err := scanConflict(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan conflict: %w", err)
+		}
+		conflicts = append(conflicts, *c)
+	}
+
+	if conflicts == nil {
+		conflicts = []model.PointConflict{}
+	}
+	return conflicts, nil
+}
+
+// ResolveConflict marks an open conflict as resolved.
+func (r *MatchRepository) ResolveConflict(ctx context.Context, conflictID uuid.UUID, resolution model.ConflictResolution, resolvedAt time.Time) error {
+	query := `
+		UPDATE point_conflicts
+		SET resolution = $2, resolved_at = $3
+		WHERE id = $1 AND resolved_at IS NULL
+	`
+	result, err := r.pool.Exec(ctx, query, conflictID, resolution, resolvedAt)
+	if err != nil {
+		return fmt.Errorf("failed to resolve conflict: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+const conflictSelect = `
+	SELECT id, match_id, device_id, position, canonical_event_id,
+		proposed_event_id, proposed_timestamp, proposed_server_player_id,
+		proposed_serve_type, proposed_winner_team,
+		resolution, created_at, resolved_at
+	FROM point_conflicts
+`
+
+// scanConflict reads a row selected with conflictSelect.
+func scanConflict(row pgx.Row) (*model.PointConflict, error) {
+	c := &model.PointConflict{}
+	err := row.Scan(
+		&c.ID, &c.MatchID, &c.DeviceID, &c.Position, &c.CanonicalEventID,
+		&c.Proposed.ID, &c.Proposed.Timestamp, &c.Proposed.ServerPlayerID,
+		&c.Proposed.ServeType, &c.Proposed.PointWinnerTeam,
+		&c.Resolution, &c.CreatedAt, &c.ResolvedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	c.Proposed.MatchID = c.MatchID
+	return c, nil
+}
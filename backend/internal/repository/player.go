@@ -15,6 +15,18 @@ var (
 	ErrNotFound    = errors.New("not found")
 	ErrDuplicateID = errors.New("duplicate id")
 	ErrInvalidData = errors.New("invalid data")
+
+	// ErrSequenceTaken is returned when a point sequence number is already
+	// used by a different event in the same match.
+	ErrSequenceTaken = errors.New("sequence number already used")
+
+	// ErrStatusChanged is returned when a match's status changed before a
+	// status update could be applied.
+	ErrStatusChanged = errors.New("match status changed")
+
+	// ErrVersionConflict is returned when a record was saved by someone
+	// else since it was loaded.
+	ErrVersionConflict = errors.New("version conflict")
 )
 
 // PlayerRepository handles player database operations.
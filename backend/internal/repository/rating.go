@@ -0,0 +1,330 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+)
+
+// RatingRepository handles player rating database operations.
+type RatingRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewRatingRepository creates a new rating repository.
+func NewRatingRepository(pool *pgxpool.Pool) *RatingRepository {
+	return &RatingRepository{pool: pool}
+}
+
+// ratingsLockID is the advisory lock key that serialises rating updates
+// across processes, such as the server and otsctl.
+const ratingsLockID = 0x4f5453
+
+// Lock takes the ratings advisory lock, waiting for any other holder. The
+// returned function releases it.
+func (r *RatingRepository) Lock(ctx context.Context) (func(), error) {
+	conn, err := r.pool.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock($1)`, ratingsLockID); err != nil {
+		conn.Release()
+		return nil, fmt.Errorf("failed to lock ratings: %w", err)
+	}
+
+	return func() {
+		if _, err := conn.Exec(context.Background(), `SELECT pg_advisory_unlock($1)`, ratingsLockID); err != nil {
+			// Closing the connection releases the lock
+			conn.Hijack().Close(context.Background())
+			return
+		}
+		conn.Release()
+	}, nil
+}
+
+// RatedMatch is a completed match with a winner, as rated by the ratings.
+type RatedMatch struct {
+	ID         uuid.UUID
+	MatchType  model.MatchType
+	StartedAt  time.Time
+	WinnerTeam model.Team
+	Players    []model.MatchPlayer
+}
+
+const ratingColumns = `r.player_id, p.name, r.kind, r.rating, r.deviation, r.volatility, r.matches, r.updated_at`
+
+var ratingHistoryColumns = []string{"player_id", "match_id", "kind", "played_at", "rating_before", "rating", "deviation", "volatility"}
+
+func scanRating(row pgx.Row) (model.PlayerRating, error) {
+	var pr model.PlayerRating
+	err := row.Scan(&pr.PlayerID, &pr.PlayerName, &pr.Kind, &pr.Rating, &pr.Deviation, &pr.Volatility, &pr.Matches, &pr.UpdatedAt)
+	return pr, err
+}
+
+// ListRatedMatches returns every completed match with a winner, in the
+// order it was played.
+func (r *RatingRepository) ListRatedMatches(ctx context.Context) ([]RatedMatch, error) {
+	return r.listRatedMatches(ctx, nil)
+}
+
+// ListRatedMatchesFrom returns the completed matches with a winner that
+// started at or after from, in the order they were played.
+func (r *RatingRepository) ListRatedMatchesFrom(ctx context.Context, from time.Time) ([]RatedMatch, error) {
+	return r.listRatedMatches(ctx, &from)
+}
+
+func (r *RatingRepository) listRatedMatches(ctx context.Context, from *time.Time) ([]RatedMatch, error) {
+	query := `
+		SELECT m.id, m.match_type, m.started_at, m.winner_team, mp.player_id, mp.team
+		FROM matches m
+		JOIN match_players mp ON mp.match_id = m.id
+		WHERE m.status = 'completed' AND m.winner_team IS NOT NULL
+		  AND ($1::timestamptz IS NULL OR m.started_at >= $1)
+		ORDER BY m.started_at ASC, m.id ASC, mp.team ASC, mp.player_id ASC
+	`
+	rows, err := r.pool.Query(ctx, query, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rated matches: %w", err)
+	}
+	defer rows.Close()
+
+	matches := []RatedMatch{}
+	for rows.Next() {
+		var m RatedMatch
+		var mp model.MatchPlayer
+		if err := rows.Scan(&m.ID, &m.MatchType, &m.StartedAt, &m.WinnerTeam, &mp.PlayerID, &mp.Team); err != nil {
+			return nil, fmt.Errorf("failed to scan rated match: %w", err)
+		}
+		mp.MatchID = m.ID
+		if n := len(matches); n > 0 && matches[n-1].ID == m.ID {
+			matches[n-1].Players = append(matches[n-1].Players, mp)
+			continue
+		}
+		m.Players = []model.MatchPlayer{mp}
+		matches = append(matches, m)
+	}
+	return matches, rows.Err()
+}
+
+// LatestPlayedAt returns when the most recently played rated match started,
+// or nil if nothing has been rated.
+func (r *RatingRepository) LatestPlayedAt(ctx context.Context) (*time.Time, error) {
+	var latest *time.Time
+	err := r.pool.QueryRow(ctx, `SELECT MAX(played_at) FROM player_rating_history`).Scan(&latest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest rated match: %w", err)
+	}
+	return latest, nil
+}
+
+// RatingsBefore returns every player's ratings as they stood before the
+// matches played at or after a time, rebuilt from the rating history.
+func (r *RatingRepository) RatingsBefore(ctx context.Context, before time.Time) ([]model.PlayerRating, error) {
+	query := `
+		SELECT DISTINCT ON (h.player_id, h.kind)
+			h.player_id, p.name, h.kind, h.rating, h.deviation, h.volatility,
+			COUNT(*) OVER (PARTITION BY h.player_id, h.kind), h.played_at
+		FROM player_rating_history h
+		JOIN players p ON p.id = h.player_id
+		WHERE h.played_at < $1
+		ORDER BY h.player_id, h.kind, h.played_at DESC, h.match_id DESC
+	`
+	return r.queryRatings(ctx, query, before)
+}
+
+// IsRated reports whether a match has been applied to the ratings.
+func (r *RatingRepository) IsRated(ctx context.Context, matchID uuid.UUID) (bool, error) {
+	var rated bool
+	err := r.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM player_rating_history WHERE match_id = $1)`, matchID).Scan(&rated)
+	if err != nil {
+		return false, fmt.Errorf("failed to check match rating: %w", err)
+	}
+	return rated, nil
+}
+
+// Get returns the stored ratings of a kind for the given players. Players
+// without a rating are left out.
+func (r *RatingRepository) Get(ctx context.Context, playerIDs []uuid.UUID, kind model.RatingKind) ([]model.PlayerRating, error) {
+	query := `
+		SELECT ` + ratingColumns + `
+		FROM player_ratings r
+		JOIN players p ON p.id = r.player_id
+		WHERE r.player_id = ANY($1) AND r.kind = $2
+	`
+	return r.queryRatings(ctx, query, playerIDs, kind)
+}
+
+// List returns the ratings of a kind for active players, highest first.
+func (r *RatingRepository) List(ctx context.Context, kind model.RatingKind) ([]model.PlayerRating, error) {
+	query := `
+		SELECT ` + ratingColumns + `
+		FROM player_ratings r
+		JOIN players p ON p.id = r.player_id
+		WHERE r.kind = $1 AND p.active = true
+		ORDER BY r.rating DESC, p.name ASC
+	`
+	return r.queryRatings(ctx, query, kind)
+}
+
+// ListForPlayer returns a player's ratings of every kind.
+func (r *RatingRepository) ListForPlayer(ctx context.Context, playerID uuid.UUID) ([]model.PlayerRating, error) {
+	query := `
+		SELECT ` + ratingColumns + `
+		FROM player_ratings r
+		JOIN players p ON p.id = r.player_id
+		WHERE r.player_id = $1
+		ORDER BY r.kind ASC
+	`
+	return r.queryRatings(ctx, query, playerID)
+}
+
+func (r *RatingRepository) queryRatings(ctx context.Context, query string, args ...interface{}) ([]model.PlayerRating, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ratings: %w", err)
+	}
+	defer rows.Close()
+
+	ratings := []model.PlayerRating{}
+	for rows.Next() {
+		pr, err := scanRating(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan rating: %w", err)
+		}
+		ratings = append(ratings, pr)
+	}
+	return ratings, rows.Err()
+}
+
+// History returns a player's rating changes, oldest first. An empty kind
+// returns both kinds.
+func (r *RatingRepository) History(ctx context.Context, playerID uuid.UUID, kind model.RatingKind) ([]model.RatingChange, error) {
+	query := `
+		SELECT player_id, match_id, kind, played_at, rating_before, rating, deviation, volatility
+		FROM player_rating_history
+		WHERE player_id = $1 AND ($2 = '' OR kind = $2)
+		ORDER BY played_at ASC, match_id ASC
+	`
+	rows, err := r.pool.Query(ctx, query, playerID, string(kind))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rating history: %w", err)
+	}
+	defer rows.Close()
+
+	history := []model.RatingChange{}
+	for rows.Next() {
+		var c model.RatingChange
+		if err := rows.Scan(&c.PlayerID, &c.MatchID, &c.Kind, &c.PlayedAt, &c.RatingBefore, &c.Rating, &c.Deviation, &c.Volatility); err != nil {
+			return nil, fmt.Errorf("failed to scan rating change: %w", err)
+		}
+		history = append(history, c)
+	}
+	return history, rows.Err()
+}
+
+// Save stores updated ratings and the changes that led to them.
+func (r *RatingRepository) Save(ctx context.Context, ratings []model.PlayerRating, changes []model.RatingChange) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := saveRatings(ctx, tx, ratings, changes); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// ReplaceFrom swaps the rating history of matches played at or after a time
+// for a recomputed one, and stores the ratings it leads to.
+func (r *RatingRepository) ReplaceFrom(ctx context.Context, from time.Time, ratings []model.PlayerRating, changes []model.RatingChange) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM player_rating_history WHERE played_at >= $1`, from); err != nil {
+		return fmt.Errorf("failed to clear rating history: %w", err)
+	}
+	if err := saveRatings(ctx, tx, ratings, changes); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// saveRatings upserts ratings and adds rating changes inside a transaction.
+func saveRatings(ctx context.Context, tx pgx.Tx, ratings []model.PlayerRating, changes []model.RatingChange) error {
+	query := `
+		INSERT INTO player_ratings (player_id, kind, rating, deviation, volatility, matches, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (player_id, kind) DO UPDATE
+		SET rating = EXCLUDED.rating, deviation = EXCLUDED.deviation, volatility = EXCLUDED.volatility,
+			matches = EXCLUDED.matches, updated_at = EXCLUDED.updated_at
+	`
+	for _, pr := range ratings {
+		if _, err := tx.Exec(ctx, query, pr.PlayerID, pr.Kind, pr.Rating, pr.Deviation, pr.Volatility, pr.Matches, pr.UpdatedAt); err != nil {
+			return fmt.Errorf("failed to save rating: %w", err)
+		}
+	}
+
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{"player_rating_history"}, ratingHistoryColumns, ratingHistoryRows(changes)); err != nil {
+		return fmt.Errorf("failed to save rating history: %w", err)
+	}
+	return nil
+}
+
+// Replace swaps every rating and the whole history for a recomputed set.
+func (r *RatingRepository) Replace(ctx context.Context, ratings []model.PlayerRating, changes []model.RatingChange) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM player_rating_history`); err != nil {
+		return fmt.Errorf("failed to clear rating history: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM player_ratings`); err != nil {
+		return fmt.Errorf("failed to clear ratings: %w", err)
+	}
+
+	rows := make([][]interface{}, len(ratings))
+	for i, pr := range ratings {
+		rows[i] = []interface{}{pr.PlayerID, string(pr.Kind), pr.Rating, pr.Deviation, pr.Volatility, pr.Matches, pr.UpdatedAt}
+	}
+	columns := []string{"player_id", "kind", "rating", "deviation", "volatility", "matches", "updated_at"}
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{"player_ratings"}, columns, pgx.CopyFromRows(rows)); err != nil {
+		return fmt.Errorf("failed to save ratings: %w", err)
+	}
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{"player_rating_history"}, ratingHistoryColumns, ratingHistoryRows(changes)); err != nil {
+		return fmt.Errorf("failed to save rating history: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func ratingHistoryRows(changes []model.RatingChange) pgx.CopyFromSource {
+	rows := make([][]interface{}, len(changes))
+	for i, c := range changes {
+		rows[i] = []interface{}{c.PlayerID, c.MatchID, string(c.Kind), c.PlayedAt, c.RatingBefore, c.Rating, c.Deviation, c.Volatility}
+	}
+	return pgx.CopyFromRows(rows)
+}
@@ -3,10 +3,12 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
 )
 
 // DateFilter represents a date range filter for tendencies queries.
@@ -16,6 +18,40 @@ type DateFilter struct {
 	EndDate   time.Time
 }
 
+// MatchScope limits tendencies queries to matches on one court or surface.
+// A match's surface is its court's surface, or the venue's when the court
+// has none or the match has no court.
+type MatchScope struct {
+	CourtID *uuid.UUID
+	Surface *model.Surface
+	NoCourt bool // Matches without a court, or whose court was deleted
+}
+
+// matchConditions builds the extra WHERE conditions on matches m for a date
+// filter and scope, numbering placeholders after the existing args.
+func matchConditions(dateFilter DateFilter, scope MatchScope, args []interface{}) (string, []interface{}) {
+	var conds []string
+	if dateFilter.Enabled {
+		conds = append(conds, fmt.Sprintf("AND m.ended_at >= $%d AND m.ended_at < $%d", len(args)+1, len(args)+2))
+		args = append(args, dateFilter.StartDate, dateFilter.EndDate)
+	}
+	if scope.CourtID != nil {
+		conds = append(conds, fmt.Sprintf("AND m.court_id = $%d", len(args)+1))
+		args = append(args, *scope.CourtID)
+	}
+	if scope.NoCourt {
+		conds = append(conds, "AND m.court_id IS NULL")
+	}
+	if scope.Surface != nil {
+		conds = append(conds, fmt.Sprintf(`AND COALESCE(
+				(SELECT c.surface FROM courts c WHERE c.id = m.court_id),
+				(SELECT v.surface FROM venues v WHERE v.id = m.venue_id)
+			  ) = $%d`, len(args)+1))
+		args = append(args, string(*scope.Surface))
+	}
+	return strings.Join(conds, "\n\t\t\t  "), args
+}
+
 // TendenciesRepository handles venue tendency database operations.
 type TendenciesRepository struct {
 	pool *pgxpool.Pool
@@ -34,6 +70,7 @@ type TeamMatchStats struct {
 	Player2Name         string
 	MatchesPlayed       int
 	MatchesWon          int
+	TrackedMatches      int // Matches with points; result-only matches have none
 	TotalGames          int
 	TotalDeuces         int
 	TotalGamesForDeuce  int
@@ -57,14 +94,9 @@ type PlayerMatchStats struct {
 
 // GetTeamStatsAtVenue retrieves aggregated team statistics for a venue.
 // Returns only doubles teams that have played at this venue.
-func (r *TendenciesRepository) GetTeamStatsAtVenue(ctx context.Context, venueID uuid.UUID, dateFilter DateFilter) ([]TeamMatchStats, error) {
-	// Build date filter condition
-	dateCondition := ""
-	args := []interface{}{venueID}
-	if dateFilter.Enabled {
-		dateCondition = "AND m.ended_at >= $2 AND m.ended_at < $3"
-		args = append(args, dateFilter.StartDate, dateFilter.EndDate)
-	}
+func (r *TendenciesRepository) GetTeamStatsAtVenue(ctx context.Context, venueID uuid.UUID, dateFilter DateFilter, scope MatchScope) ([]TeamMatchStats, error) {
+	// Build date, court and surface conditions
+	dateCondition, args := matchConditions(dateFilter, scope, []interface{}{venueID})
 
 	// Query to get all doubles teams and their match counts at the venue.
 	// A team is identified by the pair of player IDs (sorted to ensure consistency).
@@ -76,6 +108,7 @@ func (r *TendenciesRepository) GetTeamStatsAtVenue(ctx context.Context, venueID
 			WHERE m.venue_id = $1
 			  AND m.match_type = 'doubles'
 			  AND m.ended_at IS NOT NULL
+			  AND m.status <> 'abandoned'
 			  %s
 		),
 		team_compositions AS (
@@ -91,19 +124,26 @@ func (r *TendenciesRepository) GetTeamStatsAtVenue(ctx context.Context, venueID
 			GROUP BY dm.match_id, mp.team
 		),
 		match_outcomes AS (
-			-- Compute winner for each match based on point events
+			-- Use the stored winner; matches completed before results were
+			-- stored fall back to whoever won more points (no winner if level).
+			-- Matches with no points only count if recorded as result-only.
 			SELECT 
-				pe.match_id,
-				CASE 
+				m.id as match_id,
+				COALESCE(m.winner_team, CASE 
 					WHEN SUM(CASE WHEN pe.point_winner_team = 'A' THEN 1 ELSE 0 END) > 
 					     SUM(CASE WHEN pe.point_winner_team = 'B' THEN 1 ELSE 0 END)
 					THEN 'A'
-					ELSE 'B'
-				END as winning_team,
-				COUNT(*) as total_points
-			FROM point_events pe
-			WHERE pe.match_id IN (SELECT match_id FROM doubles_matches)
-			GROUP BY pe.match_id
+					WHEN SUM(CASE WHEN pe.point_winner_team = 'B' THEN 1 ELSE 0 END) > 
+					     SUM(CASE WHEN pe.point_winner_team = 'A' THEN 1 ELSE 0 END)
+					THEN 'B'
+				END) as winning_team,
+				COUNT(pe.id) as total_points,
+				m.result_only
+			FROM doubles_matches dm
+			JOIN matches m ON m.id = dm.match_id
+			LEFT JOIN point_events pe ON pe.match_id = m.id
+			GROUP BY m.id, m.winner_team, m.result_only
+			HAVING COUNT(pe.id) > 0 OR m.result_only
 		),
 		team_matches AS (
 			-- Join teams with their match outcomes
@@ -115,6 +155,7 @@ func (r *TendenciesRepository) GetTeamStatsAtVenue(ctx context.Context, venueID
 				tc.match_id,
 				tc.team,
 				CASE WHEN mo.winning_team = tc.team THEN 1 ELSE 0 END as won,
+				CASE WHEN mo.result_only THEN 0 ELSE 1 END as tracked,
 				mo.total_points as total_games
 			FROM team_compositions tc
 			JOIN match_outcomes mo ON mo.match_id = tc.match_id
@@ -127,6 +168,7 @@ func (r *TendenciesRepository) GetTeamStatsAtVenue(ctx context.Context, venueID
 			player2_name,
 			COUNT(*) as matches_played,
 			SUM(won) as matches_won,
+			SUM(tracked) as tracked_matches,
 			SUM(total_games) as total_games
 		FROM team_matches
 		GROUP BY player1_id, player2_id, player1_name, player2_name
@@ -149,6 +191,7 @@ func (r *TendenciesRepository) GetTeamStatsAtVenue(ctx context.Context, venueID
 			&ts.Player2Name,
 			&ts.MatchesPlayed,
 			&ts.MatchesWon,
+			&ts.TrackedMatches,
 			&ts.TotalGames,
 		); err != nil {
 			return nil, fmt.Errorf("failed to scan team stats: %w", err)
@@ -163,14 +206,9 @@ func (r *TendenciesRepository) GetTeamStatsAtVenue(ctx context.Context, venueID
 }
 
 // GetTeamServeStatsAtVenue retrieves first serve stats for teams at a venue.
-func (r *TendenciesRepository) GetTeamServeStatsAtVenue(ctx context.Context, venueID uuid.UUID, player1ID, player2ID uuid.UUID, dateFilter DateFilter) (firstServesIn, firstServesTotal, firstServePointsWon int, err error) {
-	// Build date filter condition
-	dateCondition := ""
-	args := []interface{}{venueID, player1ID, player2ID}
-	if dateFilter.Enabled {
-		dateCondition = "AND m.ended_at >= $4 AND m.ended_at < $5"
-		args = append(args, dateFilter.StartDate, dateFilter.EndDate)
-	}
+func (r *TendenciesRepository) GetTeamServeStatsAtVenue(ctx context.Context, venueID uuid.UUID, player1ID, player2ID uuid.UUID, dateFilter DateFilter, scope MatchScope) (firstServesIn, firstServesTotal, firstServePointsWon int, err error) {
+	// Build date, court and surface conditions
+	dateCondition, args := matchConditions(dateFilter, scope, []interface{}{venueID, player1ID, player2ID})
 
 	query := fmt.Sprintf(`
 		SELECT 
@@ -186,6 +224,7 @@ func (r *TendenciesRepository) GetTeamServeStatsAtVenue(ctx context.Context, ven
 		WHERE m.venue_id = $1
 		  AND m.match_type = 'doubles'
 		  AND m.ended_at IS NOT NULL
+		  AND m.status <> 'abandoned'
 		  AND pe.server_player_id IN ($2, $3)
 		  AND EXISTS (
 			SELECT 1 FROM match_players mp1 
@@ -209,26 +248,23 @@ func (r *TendenciesRepository) GetTeamServeStatsAtVenue(ctx context.Context, ven
 }
 
 // GetPlayerStatsAtVenue retrieves aggregated player statistics for a venue.
-func (r *TendenciesRepository) GetPlayerStatsAtVenue(ctx context.Context, venueID uuid.UUID, dateFilter DateFilter) ([]PlayerMatchStats, error) {
-	// Build date filter condition
-	dateCondition := ""
-	args := []interface{}{venueID}
-	if dateFilter.Enabled {
-		dateCondition = "AND m.ended_at >= $2 AND m.ended_at < $3"
-		args = append(args, dateFilter.StartDate, dateFilter.EndDate)
-	}
+func (r *TendenciesRepository) GetPlayerStatsAtVenue(ctx context.Context, venueID uuid.UUID, dateFilter DateFilter, scope MatchScope) ([]PlayerMatchStats, error) {
+	// Build date, court and surface conditions
+	dateCondition, args := matchConditions(dateFilter, scope, []interface{}{venueID})
 
 	query := fmt.Sprintf(`
 		WITH venue_matches AS (
 			-- Get all completed matches at this venue
-			SELECT m.id as match_id
+			SELECT m.id as match_id, m.result_only
 			FROM matches m
 			WHERE m.venue_id = $1
 			  AND m.ended_at IS NOT NULL
+			  AND m.status <> 'abandoned'
 			  %s
 		),
 		player_matches AS (
-			-- Get distinct matches per player at venue
+			-- Get distinct matches per player at venue. Result-only matches
+			-- have no points, so they don't count towards the player stats.
 			SELECT 
 				mp.player_id,
 				p.name as player_name,
@@ -236,6 +272,7 @@ func (r *TendenciesRepository) GetPlayerStatsAtVenue(ctx context.Context, venueI
 			FROM venue_matches vm
 			JOIN match_players mp ON mp.match_id = vm.match_id
 			JOIN players p ON p.id = mp.player_id
+			WHERE NOT vm.result_only
 			GROUP BY mp.player_id, p.name
 		),
 		player_serve_stats AS (
@@ -243,8 +280,9 @@ func (r *TendenciesRepository) GetPlayerStatsAtVenue(ctx context.Context, venueI
 			SELECT 
 				pe.server_player_id as player_id,
 				SUM(CASE WHEN pe.serve_type = 'first' THEN 1 ELSE 0 END) as first_serves_in,
-				COUNT(*) as first_serves_total,
-				SUM(CASE WHEN pe.serve_type = 'double_fault' THEN 1 ELSE 0 END) as double_faults
+				SUM(CASE WHEN pe.serve_type <> 'unknown' THEN 1 ELSE 0 END) as first_serves_total,
+				SUM(CASE WHEN pe.serve_type = 'double_fault' THEN 1 ELSE 0 END) as double_faults,
+				COUNT(*) as points_served
 			FROM point_events pe
 			JOIN venue_matches vm ON vm.match_id = pe.match_id
 			GROUP BY pe.server_player_id
@@ -267,7 +305,7 @@ func (r *TendenciesRepository) GetPlayerStatsAtVenue(ctx context.Context, venueI
 			COALESCE(pss.first_serves_in, 0) as first_serves_in,
 			COALESCE(pss.first_serves_total, 0) as first_serves_total,
 			COALESCE(pss.double_faults, 0) as double_faults,
-			COALESCE(pss.first_serves_total, 0) as total_games_served,
+			COALESCE(pss.points_served, 0) as total_games_served,
 			COALESCE(pp.total_points_won, 0) as total_points_won,
 			COALESCE(pp.total_points_in_matches, 0) as total_games
 		FROM player_matches pm
@@ -0,0 +1,366 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/tournament"
+)
+
+// TournamentRepository handles tournament database operations. Tournaments
+// are saved and loaded whole as the tournament engine's state.
+type TournamentRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewTournamentRepository creates a new tournament repository.
+func NewTournamentRepository(pool *pgxpool.Pool) *TournamentRepository {
+	return &TournamentRepository{pool: pool}
+}
+
+var (
+	tournamentTeamColumns     = []string{"id", "tournament_id", "team_number", "player1_id", "player2_id"}
+	tournamentMatchColumns    = []string{"id", "tournament_id", "stage", "position", "match_order", "team_a_id", "team_b_id", "scoring_match_id", "winner_team_id", "sets_a", "sets_b", "games_a", "games_b", "completed"}
+	tournamentStandingColumns = []string{"tournament_id", "team_id", "position", "rank", "played", "won", "lost", "points", "sets_won", "sets_lost", "games_won", "games_lost", "tie_break"}
+)
+
+// Create stores a new tournament at version 1.
+func (r *TournamentRepository) Create(ctx context.Context, state *tournament.TournamentState) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO tournaments (id, venue_id, player_ids, stage, winner_team_id, completed, team_seed, version, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, 1, $8, NOW())
+	`
+	_, err = tx.Exec(ctx, query, state.ID, state.VenueID, state.PlayerIDs, string(state.Stage), state.Winner, state.Completed, state.TeamSeed, state.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create tournament: %w", err)
+	}
+
+	if err := insertTournamentRows(ctx, tx, state); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Get loads a tournament with the version it was saved at.
+func (r *TournamentRepository) Get(ctx context.Context, id uuid.UUID) (*tournament.TournamentState, int, error) {
+	// One snapshot, so a concurrent save cannot mix two versions
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	state := &tournament.TournamentState{ID: id}
+	var stage string
+	var version int
+	query := `
+		SELECT venue_id, player_ids, stage, winner_team_id, completed, team_seed, version, created_at
+		FROM tournaments
+		WHERE id = $1
+	`
+	err = tx.QueryRow(ctx, query, id).Scan(&state.VenueID, &state.PlayerIDs, &stage, &state.Winner, &state.Completed, &state.TeamSeed, &version, &state.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, 0, ErrNotFound
+		}
+		return nil, 0, fmt.Errorf("failed to get tournament: %w", err)
+	}
+	state.Stage = tournament.TournamentStage(stage)
+
+	if state.Teams, err = getTournamentTeams(ctx, tx, id); err != nil {
+		return nil, 0, err
+	}
+	matches, err := getTournamentMatches(ctx, tx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, m := range matches {
+		if m.Stage == tournament.StageRR {
+			state.RoundRobinMatches = append(state.RoundRobinMatches, m)
+		} else {
+			state.KnockoutMatches = append(state.KnockoutMatches, m)
+		}
+	}
+	if state.Standings, err = getTournamentStandings(ctx, tx, id); err != nil {
+		return nil, 0, err
+	}
+
+	return state, version, nil
+}
+
+// Save replaces a tournament's state if it is still at the version it was
+// loaded at, and returns the new version. Returns ErrVersionConflict if
+// someone else saved it first.
+func (r *TournamentRepository) Save(ctx context.Context, state *tournament.TournamentState, version int) (int, error) {
+	return r.save(ctx, state, version, nil)
+}
+
+// SaveChangingStatus is Save that also changes the status of a scoring
+// match in the same transaction, as MatchRepository.ChangeStatus does.
+func (r *TournamentRepository) SaveChangingStatus(ctx context.Context, state *tournament.TournamentState, version int, change StatusChange) (int, error) {
+	return r.save(ctx, state, version, func(ctx context.Context, tx pgx.Tx) error {
+		return changeStatus(ctx, tx, change)
+	})
+}
+
+// SaveDeletingMatch is Save that also deletes a scoring match in the same
+// transaction, for a state that no longer refers to it. Returns ErrNotFound
+// if the scoring match is already gone.
+func (r *TournamentRepository) SaveDeletingMatch(ctx context.Context, state *tournament.TournamentState, version int, scoringMatchID uuid.UUID) (int, error) {
+	return r.save(ctx, state, version, func(ctx context.Context, tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, `DELETE FROM matches WHERE id = $1`, scoringMatchID)
+		if err != nil {
+			return fmt.Errorf("failed to delete match: %w", err)
+		}
+		if result.RowsAffected() == 0 {
+			return ErrNotFound
+		}
+		return nil
+	})
+}
+
+// save stores a tournament's state, running also, if set, in the same
+// transaction.
+func (r *TournamentRepository) save(ctx context.Context, state *tournament.TournamentState, version int, also func(context.Context, pgx.Tx) error) (int, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE tournaments
+		SET stage = $3, winner_team_id = $4, completed = $5, team_seed = $6, version = version + 1, updated_at = NOW()
+		WHERE id = $1 AND version = $2
+		RETURNING version
+	`
+	var saved int
+	err = tx.QueryRow(ctx, query, state.ID, version, string(state.Stage), state.Winner, state.Completed, state.TeamSeed).Scan(&saved)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return 0, fmt.Errorf("failed to save tournament: %w", err)
+		}
+		var exists bool
+		if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM tournaments WHERE id = $1)`, state.ID).Scan(&exists); err != nil {
+			return 0, fmt.Errorf("failed to check tournament: %w", err)
+		}
+		if !exists {
+			return 0, ErrNotFound
+		}
+		return 0, ErrVersionConflict
+	}
+
+	for _, table := range []string{"tournament_standings", "tournament_matches", "tournament_teams"} {
+		if _, err := tx.Exec(ctx, `DELETE FROM `+table+` WHERE tournament_id = $1`, state.ID); err != nil {
+			return 0, fmt.Errorf("failed to clear %s: %w", table, err)
+		}
+	}
+	if err := insertTournamentRows(ctx, tx, state); err != nil {
+		return 0, err
+	}
+
+	if also != nil {
+		if err := also(ctx, tx); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return saved, nil
+}
+
+// insertTournamentRows stores a tournament's teams, matches and standings.
+func insertTournamentRows(ctx context.Context, tx pgx.Tx, state *tournament.TournamentState) error {
+	teams := make([][]interface{}, len(state.Teams))
+	for i, t := range state.Teams {
+		teams[i] = []interface{}{t.ID, state.ID, t.TeamNumber, t.Player1ID, t.Player2ID}
+	}
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{"tournament_teams"}, tournamentTeamColumns, pgx.CopyFromRows(teams)); err != nil {
+		return fmt.Errorf("failed to save tournament teams: %w", err)
+	}
+
+	all := tournament.GetAllMatches(state)
+	matches := make([][]interface{}, len(all))
+	for i, m := range all {
+		matches[i] = []interface{}{
+			m.ID, state.ID, string(m.Stage), i, m.MatchOrder,
+			optionalID(m.TeamAID), optionalID(m.TeamBID), m.ScoringMatchID, m.WinnerTeamID,
+			m.SetsA, m.SetsB, m.GamesA, m.GamesB, m.Completed,
+		}
+	}
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{"tournament_matches"}, tournamentMatchColumns, pgx.CopyFromRows(matches)); err != nil {
+		return fmt.Errorf("failed to save tournament matches: %w", err)
+	}
+
+	standings := make([][]interface{}, len(state.Standings))
+	for i, s := range state.Standings {
+		standings[i] = []interface{}{
+			state.ID, s.TeamID, i, s.Rank, s.Played, s.Won, s.Lost, s.Points,
+			s.SetsWon, s.SetsLost, s.GamesWon, s.GamesLost, string(s.TieBreak),
+		}
+	}
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{"tournament_standings"}, tournamentStandingColumns, pgx.CopyFromRows(standings)); err != nil {
+		return fmt.Errorf("failed to save tournament standings: %w", err)
+	}
+	return nil
+}
+
+func getTournamentTeams(ctx context.Context, tx pgx.Tx, tournamentID uuid.UUID) ([]tournament.Team, error) {
+	query := `
+		SELECT id, team_number, player1_id, player2_id
+		FROM tournament_teams
+		WHERE tournament_id = $1
+		ORDER BY team_number ASC
+	`
+	rows, err := tx.Query(ctx, query, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tournament teams: %w", err)
+	}
+	defer rows.Close()
+
+	var teams []tournament.Team
+	for rows.Next() {
+		var t tournament.Team
+		if err := rows.Scan(&t.ID, &t.TeamNumber, &t.Player1ID, &t.Player2ID); err != nil {
+			return nil, fmt.Errorf("failed to scan tournament team: %w", err)
+		}
+		teams = append(teams, t)
+	}
+	return teams, rows.Err()
+}
+
+func getTournamentMatches(ctx context.Context, tx pgx.Tx, tournamentID uuid.UUID) ([]tournament.Match, error) {
+	query := `
+		SELECT id, stage, match_order, team_a_id, team_b_id, scoring_match_id, winner_team_id,
+			sets_a, sets_b, games_a, games_b, completed
+		FROM tournament_matches
+		WHERE tournament_id = $1
+		ORDER BY position ASC
+	`
+	rows, err := tx.Query(ctx, query, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tournament matches: %w", err)
+	}
+	defer rows.Close()
+
+	var matches []tournament.Match
+	for rows.Next() {
+		m := tournament.Match{TournamentID: tournamentID}
+		var stage string
+		var teamA, teamB *uuid.UUID
+		err := rows.Scan(
+			&m.ID, &stage, &m.MatchOrder, &teamA, &teamB, &m.ScoringMatchID, &m.WinnerTeamID,
+			&m.SetsA, &m.SetsB, &m.GamesA, &m.GamesB, &m.Completed,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan tournament match: %w", err)
+		}
+		m.Stage = tournament.MatchStage(stage)
+		if teamA != nil {
+			m.TeamAID = *teamA
+		}
+		if teamB != nil {
+			m.TeamBID = *teamB
+		}
+		matches = append(matches, m)
+	}
+	return matches, rows.Err()
+}
+
+func getTournamentStandings(ctx context.Context, tx pgx.Tx, tournamentID uuid.UUID) ([]tournament.TeamStanding, error) {
+	query := `
+		SELECT team_id, rank, played, won, lost, points, sets_won, sets_lost, games_won, games_lost, tie_break
+		FROM tournament_standings
+		WHERE tournament_id = $1
+		ORDER BY position ASC
+	`
+	rows, err := tx.Query(ctx, query, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tournament standings: %w", err)
+	}
+	defer rows.Close()
+
+	var standings []tournament.TeamStanding
+	for rows.Next() {
+		var s tournament.TeamStanding
+		var tieBreak string
+		err := rows.Scan(
+			&s.TeamID, &s.Rank, &s.Played, &s.Won, &s.Lost, &s.Points,
+			&s.SetsWon, &s.SetsLost, &s.GamesWon, &s.GamesLost, &tieBreak,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan tournament standing: %w", err)
+		}
+		s.TieBreak = tournament.TieBreak(tieBreak)
+		standings = append(standings, s)
+	}
+	return standings, rows.Err()
+}
+
+// optionalID stores a not-yet-known team (uuid.Nil) as NULL.
+func optionalID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
+
+// GetLinkedMatch finds the tournament match a scoring match is played as.
+// Returns ErrNotFound if the scoring match is not part of a tournament.
+func (r *TournamentRepository) GetLinkedMatch(ctx context.Context, scoringMatchID uuid.UUID) (tournamentID, matchID uuid.UUID, err error) {
+	query := `
+		SELECT tournament_id, id
+		FROM tournament_matches
+		WHERE scoring_match_id = $1
+	`
+	err = r.pool.QueryRow(ctx, query, scoringMatchID).Scan(&tournamentID, &matchID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, uuid.Nil, ErrNotFound
+		}
+		return uuid.Nil, uuid.Nil, fmt.Errorf("failed to get tournament match: %w", err)
+	}
+	return tournamentID, matchID, nil
+}
+
+// List retrieves tournaments newest first, optionally at one venue.
+func (r *TournamentRepository) List(ctx context.Context, venueID *uuid.UUID) ([]model.TournamentSummary, error) {
+	query := `
+		SELECT id, venue_id, cardinality(player_ids), stage, winner_team_id, completed, version, created_at
+		FROM tournaments
+		WHERE ($1::uuid IS NULL OR venue_id = $1)
+		ORDER BY created_at DESC, id DESC
+	`
+	rows, err := r.pool.Query(ctx, query, venueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tournaments: %w", err)
+	}
+	defer rows.Close()
+
+	tournaments := []model.TournamentSummary{}
+	for rows.Next() {
+		var t model.TournamentSummary
+		if err := rows.Scan(&t.ID, &t.VenueID, &t.PlayerCount, &t.Stage, &t.WinnerTeamID, &t.Completed, &t.Version, &t.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan tournament: %w", err)
+		}
+		tournaments = append(tournaments, t)
+	}
+	return tournaments, rows.Err()
+}
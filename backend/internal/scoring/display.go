@@ -1,5 +1,7 @@
 package scoring
 
+import "strconv"
+
 // ═══════════════════════════════════════════════════════════════════════════
 // TENNIS SCORING ENGINE - DISPLAY LOGIC
 // ═══════════════════════════════════════════════════════════════════════════
@@ -171,6 +173,31 @@ func IsTieBreak(gamesA, gamesB int) bool {
 	return gamesA == 6 && gamesB == 6
 }
 
+// InTieBreak reports whether the current game is played as a tie-break.
+// Only matches scored with tie-breaks enabled play one at 6-6.
+func InTieBreak(state *MatchState) bool {
+	return state.Mode == ModeStandard && state.TieBreaks && IsTieBreak(state.GamesA, state.GamesB)
+}
+
+// IsTieBreakWon checks if a tie-break has been won.
+//
+// Win Condition (per spec Section 4.2):
+//   - Points ≥ 7
+//   - Lead by ≥ 2 points
+func IsTieBreakWon(pointsA, pointsB int) *Team {
+	if pointsA >= 7 && pointsA-pointsB >= 2 {
+		a := TeamA
+		return &a
+	}
+
+	if pointsB >= 7 && pointsB-pointsA >= 2 {
+		b := TeamB
+		return &b
+	}
+
+	return nil
+}
+
 // GetMatchDisplay returns the complete user-facing display of the match.
 //
 // This is the PRIMARY interface for UI rendering.
@@ -193,7 +220,7 @@ func GetMatchDisplay(state *MatchState) MatchDisplay {
 	// Mode-specific additions
 	if state.Mode == ModeShortFormat {
 		// Short-format mode
-		display.TotalGames = 3
+		display.TotalGames = shortFormatGames(state)
 		display.Sets = nil
 
 		// Server (if defined)
@@ -207,6 +234,14 @@ func GetMatchDisplay(state *MatchState) MatchDisplay {
 		display.Sets = &sets
 		display.TotalGames = 0 // Variable in standard mode
 		display.IsTieBreak = IsTieBreak(state.GamesA, state.GamesB)
+
+		// Tie-break points are counted, not called 15/30/40
+		if InTieBreak(state) {
+			display.Points = PointDisplay{
+				A: strconv.Itoa(state.CurrentGame.PointsA),
+				B: strconv.Itoa(state.CurrentGame.PointsB),
+			}
+		}
 	}
 
 	return display
@@ -18,11 +18,14 @@ import (
 // Parameters:
 //   - mode: ModeStandard or ModeShortFormat
 //   - players: Team assignments for all players
-//   - servers: For short-format only, exactly 3 server IDs in order.
+//   - servers: For short-format only, 2 to 4 server IDs in order.
 //     For standard mode, pass nil.
 //
+// Short-format matches are best of 3 games unless BestOf is set to 5
+// before scoring.
+//
 // Validation:
-//   - Short-format requires exactly 3 servers
+//   - Short-format requires 2 to 4 servers
 //   - Standard mode must have nil servers
 //   - Teams must have players assigned
 //
@@ -40,8 +43,8 @@ func NewMatchState(mode MatchMode, players TeamPlayers, servers []string) (*Matc
 
 	// Validate servers based on mode
 	if mode == ModeShortFormat {
-		if len(servers) != 3 {
-			return nil, errors.New("short-format mode requires exactly 3 servers")
+		if len(servers) < 2 || len(servers) > 4 {
+			return nil, errors.New("short-format mode requires 2 to 4 servers")
 		}
 	} else {
 		if servers != nil {
@@ -115,6 +118,9 @@ func ScorePoint(state *MatchState, team Team) (*MatchState, error) {
 
 	// Check if game is won
 	winner := IsGameWon(newState.CurrentGame.PointsA, newState.CurrentGame.PointsB)
+	if InTieBreak(newState) {
+		winner = IsTieBreakWon(newState.CurrentGame.PointsA, newState.CurrentGame.PointsB)
+	}
 
 	if winner != nil {
 		// Game won - handle game completion
@@ -378,6 +378,58 @@ func TestStandardModeCloseSet(t *testing.T) {
 	}
 }
 
+func TestStandardModeTieBreak(t *testing.T) {
+	players := createTestPlayers()
+
+	state, err := NewMatchState(ModeStandard, players, nil)
+	if err != nil {
+		t.Fatalf("Failed to create match: %v", err)
+	}
+	state.TieBreaks = true
+
+	// Play to 6-6
+	for i := 0; i < 6; i++ {
+		state = scorePoints(t, state, "AAAA")
+		state = scorePoints(t, state, "BBBB")
+	}
+	if !InTieBreak(state) {
+		t.Fatal("Expected a tie-break at 6-6")
+	}
+
+	// 4-0 would win a normal game but not a tie-break
+	state = scorePoints(t, state, "AAAA")
+	if state.SetsA != 0 {
+		t.Fatal("Tie-break should not be won at 4-0")
+	}
+	display := GetMatchDisplay(state)
+	if display.Points.A != "4" || display.Points.B != "0" {
+		t.Errorf("Expected tie-break points 4-0, got %s-%s", display.Points.A, display.Points.B)
+	}
+
+	// 4-6, back to 6-6, then two in a row
+	state = scorePoints(t, state, "BBBBBB")
+	state = scorePoints(t, state, "AAAA")
+	if state.SetsA != 1 || state.GamesA != 0 {
+		t.Errorf("Expected A to win the set 7-6, got sets %d games %d-%d", state.SetsA, state.GamesA, state.GamesB)
+	}
+}
+
+func TestSixAllWithoutTieBreaks(t *testing.T) {
+	players := createTestPlayers()
+
+	state, _ := NewMatchState(ModeStandard, players, nil)
+	for i := 0; i < 6; i++ {
+		state = scorePoints(t, state, "AAAA")
+		state = scorePoints(t, state, "BBBB")
+	}
+
+	// Without tie-breaks the 6-6 game is a normal game
+	state = scorePoints(t, state, "AAAA")
+	if state.SetsA != 1 {
+		t.Errorf("Expected the 6-6 game to decide the set, got sets %d", state.SetsA)
+	}
+}
+
 func TestSetWinConditions(t *testing.T) {
 	tests := []struct {
 		gamesA   int
@@ -412,6 +464,40 @@ func TestSetWinConditions(t *testing.T) {
 	}
 }
 
+func TestShortFormatBestOfFive(t *testing.T) {
+	players := createTestPlayers()
+	servers := []string{"player1", "player2"}
+
+	state, err := NewMatchState(ModeShortFormat, players, servers)
+	if err != nil {
+		t.Fatalf("Failed to create match: %v", err)
+	}
+	state.BestOf = 5
+
+	// Games 1-4 split 2-2; the servers take turns
+	for i, game := range []string{"AAAA", "BBBB", "AAAA", "BBBB"} {
+		if server := GetCurrentServer(state); server != servers[i%2] {
+			t.Errorf("Game %d: expected server %s, got %s", i+1, servers[i%2], server)
+		}
+		state = scorePoints(t, state, game)
+	}
+
+	// Best of 5 is not decided at 2-2
+	if state.Completed {
+		t.Fatal("Match should not be complete at 2-2")
+	}
+	if state.CurrentGame.GameNumber != 5 || GetCurrentServer(state) != "player1" {
+		t.Errorf("Expected game 5 served by player1, got game %d served by %s",
+			state.CurrentGame.GameNumber, GetCurrentServer(state))
+	}
+
+	// Game 5: A wins 3-2
+	state = scorePoints(t, state, "AAAA")
+	if !state.Completed || state.Winner == nil || *state.Winner != TeamA {
+		t.Errorf("Expected TeamA to win 3-2, got completed=%v winner=%v", state.Completed, state.Winner)
+	}
+}
+
 // ─────────────────────────────────────────────────────────────────────────────
 // VALIDATION TESTS
 // ─────────────────────────────────────────────────────────────────────────────
@@ -426,9 +512,13 @@ func TestInvalidMatchCreation(t *testing.T) {
 	}
 
 	// Short format with wrong number of servers
-	_, err = NewMatchState(ModeShortFormat, players, []string{"p1", "p2"})
+	_, err = NewMatchState(ModeShortFormat, players, []string{"p1"})
+	if err == nil {
+		t.Error("Expected error for short format with 1 server")
+	}
+	_, err = NewMatchState(ModeShortFormat, players, []string{"p1", "p2", "p3", "p4", "p5"})
 	if err == nil {
-		t.Error("Expected error for short format with 2 servers")
+		t.Error("Expected error for short format with 5 servers")
 	}
 
 	// Standard format with servers
@@ -0,0 +1,196 @@
+package scoring
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// TENNIS SCORING ENGINE - FINAL SCORES
+// ═══════════════════════════════════════════════════════════════════════════
+// Parses and validates a reported final score such as "6-3 4-6 7-6(5)".
+//
+// Format:
+//   - Standard: one "games-games" pair per set, Team A first, separated by
+//     spaces or commas. A 7-6 set may note the loser's tie-break points,
+//     e.g. "7-6(5)".
+//   - Short-Format: the games won, e.g. "2-1", or "3-2" in best of 5.
+//
+// Each set must end exactly where the engine would end it (IsSetWon), and
+// the match must be decided by the last set with no sets after that.
+// ═══════════════════════════════════════════════════════════════════════════
+
+// SetScore is the games each team won in one set.
+type SetScore struct {
+	GamesA int
+	GamesB int
+
+	// TieBreak is the loser's points in the set's tie-break, if known
+	TieBreak *int
+}
+
+// String returns the set as "games-games", with any tie-break points.
+func (s SetScore) String() string {
+	if s.TieBreak != nil {
+		return fmt.Sprintf("%d-%d(%d)", s.GamesA, s.GamesB, *s.TieBreak)
+	}
+	return fmt.Sprintf("%d-%d", s.GamesA, s.GamesB)
+}
+
+// MatchScore is a validated final score.
+type MatchScore struct {
+	// Sets holds one entry per set (a single entry in short-format)
+	Sets []SetScore
+
+	// Winner is the team that won the match
+	Winner Team
+}
+
+// String returns the score in canonical form, e.g. "6-3 7-6(5)".
+func (s *MatchScore) String() string {
+	parts := make([]string, len(s.Sets))
+	for i, set := range s.Sets {
+		parts[i] = set.String()
+	}
+	return strings.Join(parts, " ")
+}
+
+// SetsWon returns the number of sets won by each team.
+func (s *MatchScore) SetsWon() (setsA, setsB int) {
+	for _, set := range s.Sets {
+		if set.GamesA > set.GamesB {
+			setsA++
+		} else {
+			setsB++
+		}
+	}
+	return setsA, setsB
+}
+
+// GamesWon returns the number of games won by each team over the match.
+func (s *MatchScore) GamesWon() (gamesA, gamesB int) {
+	for _, set := range s.Sets {
+		gamesA += set.GamesA
+		gamesB += set.GamesB
+	}
+	return gamesA, gamesB
+}
+
+// ParseScore parses and validates a final score for the given mode.
+func ParseScore(mode MatchMode, score string) (*MatchScore, error) {
+	fields := strings.FieldsFunc(score, func(r rune) bool {
+		return r == ' ' || r == ',' || r == '\t'
+	})
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("score is empty")
+	}
+
+	switch mode {
+	case ModeShortFormat:
+		return parseShortFormatScore(fields)
+	case ModeStandard:
+		return parseStandardScore(fields)
+	}
+	return nil, fmt.Errorf("invalid mode: %s", mode)
+}
+
+// parseStandardScore validates a best-of-3-sets score.
+func parseStandardScore(fields []string) (*MatchScore, error) {
+	result := &MatchScore{}
+	setsA, setsB := 0, 0
+
+	for i, field := range fields {
+		if setsA == 2 || setsB == 2 {
+			return nil, fmt.Errorf("set %d: match was already decided", i+1)
+		}
+
+		games, note, hasNote := strings.Cut(field, "(")
+		set, err := parseGames(games)
+		if err != nil {
+			return nil, fmt.Errorf("set %d: %w", i+1, err)
+		}
+
+		winner := IsSetWon(set.GamesA, set.GamesB)
+		if winner == nil {
+			return nil, fmt.Errorf("set %d: %d-%d is not a finished set", i+1, set.GamesA, set.GamesB)
+		}
+
+		// The set must not have been won before its last game
+		before := set
+		if *winner == TeamA {
+			before.GamesA--
+		} else {
+			before.GamesB--
+		}
+		if IsSetWon(before.GamesA, before.GamesB) != nil {
+			return nil, fmt.Errorf("set %d: %d-%d is past the end of the set", i+1, set.GamesA, set.GamesB)
+		}
+
+		if hasNote {
+			if !IsTieBreak(before.GamesA, before.GamesB) {
+				return nil, fmt.Errorf("set %d: tie-break points given for a set without a tie-break", i+1)
+			}
+			points, err := strconv.Atoi(strings.TrimSuffix(note, ")"))
+			if err != nil || !strings.HasSuffix(note, ")") || points < 0 {
+				return nil, fmt.Errorf("set %d: invalid tie-break points %q", i+1, "("+note)
+			}
+			set.TieBreak = &points
+		}
+
+		if *winner == TeamA {
+			setsA++
+		} else {
+			setsB++
+		}
+		result.Sets = append(result.Sets, set)
+	}
+
+	switch {
+	case setsA == 2:
+		result.Winner = TeamA
+	case setsB == 2:
+		result.Winner = TeamB
+	default:
+		return nil, fmt.Errorf("match is not decided: sets %d-%d", setsA, setsB)
+	}
+	return result, nil
+}
+
+// parseShortFormatScore validates a first-to-2-games score, or first to 3
+// games in best of 5.
+func parseShortFormatScore(fields []string) (*MatchScore, error) {
+	if len(fields) != 1 {
+		return nil, fmt.Errorf("short-format score is the games won, e.g. 2-1")
+	}
+
+	set, err := parseGames(fields[0])
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MatchScore{Sets: []SetScore{set}}
+	switch {
+	case (set.GamesA == 2 || set.GamesA == 3) && set.GamesB < set.GamesA:
+		result.Winner = TeamA
+	case (set.GamesB == 2 || set.GamesB == 3) && set.GamesA < set.GamesB:
+		result.Winner = TeamB
+	default:
+		return nil, fmt.Errorf("%d-%d is not a short-format result (first to 2 games, or 3 in best of 5)", set.GamesA, set.GamesB)
+	}
+	return result, nil
+}
+
+// parseGames parses a "games-games" pair.
+func parseGames(s string) (SetScore, error) {
+	a, b, ok := strings.Cut(s, "-")
+	if !ok {
+		return SetScore{}, fmt.Errorf("invalid score %q; expected games-games", s)
+	}
+	gamesA, errA := strconv.Atoi(a)
+	gamesB, errB := strconv.Atoi(b)
+	if errA != nil || errB != nil || gamesA < 0 || gamesB < 0 {
+		return SetScore{}, fmt.Errorf("invalid score %q; expected games-games", s)
+	}
+	return SetScore{GamesA: gamesA, GamesB: gamesB}, nil
+}
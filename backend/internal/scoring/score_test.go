@@ -0,0 +1,73 @@
+package scoring
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseScore(t *testing.T) {
+	tests := []struct {
+		mode   MatchMode
+		score  string
+		want   string
+		winner Team
+	}{
+		{ModeStandard, "6-3 6-4", "6-3 6-4", TeamA},
+		{ModeStandard, "3-6, 7-5, 6-7(4)", "3-6 7-5 6-7(4)", TeamB},
+		{ModeStandard, "7-6(10) 6-0", "7-6(10) 6-0", TeamA},
+		{ModeStandard, "7-6 6-0", "7-6 6-0", TeamA},
+		{ModeShortFormat, "1-2", "1-2", TeamB},
+		{ModeShortFormat, "2-0", "2-0", TeamA},
+		{ModeShortFormat, "2-3", "2-3", TeamB}, // Best of 5
+	}
+	for _, tt := range tests {
+		score, err := ParseScore(tt.mode, tt.score)
+		if err != nil {
+			t.Errorf("ParseScore(%q): %v", tt.score, err)
+			continue
+		}
+		if score.String() != tt.want || score.Winner != tt.winner {
+			t.Errorf("ParseScore(%q) = %q won by %s, want %q won by %s",
+				tt.score, score.String(), score.Winner, tt.want, tt.winner)
+		}
+	}
+}
+
+func TestParseScoreInvalid(t *testing.T) {
+	tests := []struct {
+		mode  MatchMode
+		score string
+		want  string
+	}{
+		{ModeStandard, "", "empty"},
+		{ModeStandard, "6-3", "not decided"},
+		{ModeStandard, "6-3 5-4", "not a finished set"},
+		{ModeStandard, "7-3 6-0", "past the end"},
+		{ModeStandard, "6-0 0-6 8-6", "past the end"}, // 7-6 always ends a set
+		{ModeStandard, "6-3 6-4 6-0", "already decided"},
+		{ModeStandard, "6-4(3) 6-0", "without a tie-break"},
+		{ModeStandard, "6:3 6:4", "expected games-games"},
+		{ModeShortFormat, "2-2", "first to 2 games"},
+		{ModeShortFormat, "4-1", "first to 2 games"},
+		{ModeShortFormat, "6-3 6-4", "games won"},
+	}
+	for _, tt := range tests {
+		_, err := ParseScore(tt.mode, tt.score)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ParseScore(%q): expected error containing %q, got %v", tt.score, tt.want, err)
+		}
+	}
+}
+
+func TestMatchScoreTotals(t *testing.T) {
+	score, err := ParseScore(ModeStandard, "6-4 3-6 7-5")
+	if err != nil {
+		t.Fatalf("ParseScore: %v", err)
+	}
+	if a, b := score.SetsWon(); a != 2 || b != 1 {
+		t.Errorf("expected sets 2-1, got %d-%d", a, b)
+	}
+	if a, b := score.GamesWon(); a != 16 || b != 15 {
+		t.Errorf("expected games 16-15, got %d-%d", a, b)
+	}
+}
@@ -4,15 +4,16 @@ package scoring
 // TENNIS SCORING ENGINE - SHORT-FORMAT MODE
 // ═══════════════════════════════════════════════════════════════════════════
 // Source of Truth: OTS_Tennis_Scoring_Spec.md Section 5
-// This file implements the recreational 3-game "best of 3" format.
+// This file implements the recreational "best of 3" or "best of 5" games format.
 //
 // Hierarchy: POINT → GAME → MATCH (no sets)
 //
 // Rules:
-//   - Maximum 3 games
-//   - First to win 2 games wins the match
-//   - If a side wins Games 1 and 2, Game 3 is skipped
-//   - Fixed serving order: Game 1 = Server[0], Game 2 = Server[1], Game 3 = Server[2]
+//   - Maximum 3 games (or 5 in best of 5)
+//   - First to win 2 games (3 in best of 5) wins the match
+//   - Games left once a side has won are skipped
+//   - Fixed serving order: Game 1 = Server[0], Game 2 = Server[1], ...
+//     cycling through the 2 to 4 servers
 //   - Server does NOT depend on previous game outcome
 // ═══════════════════════════════════════════════════════════════════════════
 
@@ -20,15 +21,15 @@ package scoring
 //
 // Flow:
 //  1. Increment games won for the winning team
-//  2. Check if match is won (first to 2 games)
+//  2. Check if match is won (first to 2 or 3 games)
 //  3. If match won, set winner and mark completed
 //  4. If not won, advance to next game
 //
 // Match Win Condition:
-//   - First team to win 2 games wins the match
+//   - First team to win a majority of BestOf games wins the match
 //
 // Server Rotation:
-//   - Game N is served by Servers[N-1]
+//   - Game N is served by Servers[(N-1) % len(Servers)]
 //   - Independent of game outcomes
 func handleShortFormatGameWon(state *MatchState, winner Team) *MatchState {
 	// Increment games won
@@ -38,8 +39,9 @@ func handleShortFormatGameWon(state *MatchState, winner Team) *MatchState {
 		state.GamesB++
 	}
 
-	// Check match win condition: First to 2 games
-	if state.GamesA == 2 {
+	// Check match win condition: First to a majority of games
+	toWin := shortFormatGames(state)/2 + 1
+	if state.GamesA == toWin {
 		// Team A wins match
 		a := TeamA
 		state.Winner = &a
@@ -47,7 +49,7 @@ func handleShortFormatGameWon(state *MatchState, winner Team) *MatchState {
 		return state
 	}
 
-	if state.GamesB == 2 {
+	if state.GamesB == toWin {
 		// Team B wins match
 		b := TeamB
 		state.Winner = &b
@@ -73,19 +75,27 @@ func advanceToNextGame(state *MatchState) {
 	state.CurrentGame.GameNumber++
 	state.CurrentGame.ServerIndex++
 
-	// Safety check: Ensure we don't exceed 3 games
+	// Safety check: Ensure we don't exceed the match's games
 	// (This should never happen if match win logic is correct)
-	if state.CurrentGame.GameNumber > 3 {
-		state.CurrentGame.GameNumber = 3
+	if games := shortFormatGames(state); state.CurrentGame.GameNumber > games {
+		state.CurrentGame.GameNumber = games
 	}
 
-	if state.CurrentGame.ServerIndex >= len(state.Servers) {
-		// Clamp to last server if we somehow go beyond
-		// (Should never happen in valid short-format)
-		state.CurrentGame.ServerIndex = len(state.Servers) - 1
+	// Start the serving order again once every server has served
+	if len(state.Servers) > 0 {
+		state.CurrentGame.ServerIndex %= len(state.Servers)
 	}
 }
 
+// shortFormatGames returns the number of games in a short-format match.
+// A state without BestOf is best of 3.
+func shortFormatGames(state *MatchState) int {
+	if state.BestOf == 0 {
+		return 3
+	}
+	return state.BestOf
+}
+
 // GetCurrentServer returns the current server's ID for short-format mode.
 //
 // Returns:
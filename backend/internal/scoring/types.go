@@ -17,9 +17,9 @@ const (
 	// Default: Best of 3 sets
 	ModeStandard MatchMode = "standard"
 
-	// ModeShortFormat represents recreational 3-game format:
+	// ModeShortFormat represents recreational short format:
 	// Points → Games → Match
-	// Best of 3 games, no sets
+	// Best of 3 or 5 games, no sets
 	// Fixed server rotation per game
 	ModeShortFormat MatchMode = "short"
 )
@@ -58,10 +58,15 @@ type MatchState struct {
 	// Players assigned to each team
 	Players TeamPlayers
 
-	// Servers (short-format only): Array of exactly 3 server IDs
-	// servers[0] serves Game 1, servers[1] serves Game 2, servers[2] serves Game 3
+	// Servers (short-format only): Array of 2 to 4 server IDs
+	// servers[0] serves Game 1, servers[1] serves Game 2, and so on,
+	// starting again from servers[0] when every server has served
 	Servers []string
 
+	// BestOf (short-format only): Games in the match, 3 or 5 (0 means 3)
+	// First to 2 games wins a best of 3, first to 3 a best of 5
+	BestOf int
+
 	// ─────────────────────────────────────────────────────────────────────
 	// CURRENT GAME STATE
 	// ─────────────────────────────────────────────────────────────────────
@@ -96,6 +101,10 @@ type MatchState struct {
 	// CurrentSet: Current set number (1, 2, or 3) (standard mode only)
 	CurrentSet int
 
+	// TieBreaks: Play a tie-break at 6-6 (standard mode only)
+	// Optional per spec Section 4.2. When false the 6-6 game is a normal game.
+	TieBreaks bool
+
 	// ─────────────────────────────────────────────────────────────────────
 	// MATCH RESULT
 	// ─────────────────────────────────────────────────────────────────────
@@ -119,7 +128,7 @@ type CurrentGameState struct {
 
 	// GameNumber: Current game number
 	// - Standard mode: Total games in set (1st game = 1, 2nd game = 2, etc.)
-	// - Short-format: 1, 2, or 3
+	// - Short-format: 1 up to BestOf
 	GameNumber int
 
 	// ServerIndex: Index into the Servers array (short-format only)
@@ -137,38 +146,38 @@ type TeamPlayers struct {
 // This is what gets shown in the UI - never raw point counts.
 type MatchDisplay struct {
 	// Points: Tennis notation for current game (e.g., "15", "30", "40", "Deuce", "Ad")
-	Points PointDisplay
+	Points PointDisplay `json:"points"`
 
 	// Games: Games won by each team
-	Games ScoreCount
+	Games ScoreCount `json:"games"`
 
 	// Sets: Sets won by each team (standard mode only, nil for short-format)
-	Sets *ScoreCount
+	Sets *ScoreCount `json:"sets"`
 
 	// CurrentSet: Current set number (standard mode only, 0 for short-format)
-	CurrentSet int
+	CurrentSet int `json:"current_set"`
 
 	// GameNumber: Current game number within the match
-	GameNumber int
+	GameNumber int `json:"game_number"`
 
-	// TotalGames: Total possible games (3 for short-format, variable for standard)
-	TotalGames int
+	// TotalGames: Total possible games (BestOf for short-format, variable for standard)
+	TotalGames int `json:"total_games"`
 
 	// Server: ID of the current server (nil if not applicable)
-	Server *string
+	Server *string `json:"server,omitempty"`
 
 	// IsTieBreak: True if currently in a tie-break (standard mode only)
-	IsTieBreak bool
+	IsTieBreak bool `json:"is_tie_break"`
 }
 
 // PointDisplay represents the current point score in tennis notation.
 type PointDisplay struct {
-	A string // Team A's score ("0", "15", "30", "40", "Deuce", "Ad")
-	B string // Team B's score ("0", "15", "30", "40", "Deuce", "Ad")
+	A string `json:"a"` // Team A's score ("0", "15", "30", "40", "Deuce", "Ad")
+	B string `json:"b"` // Team B's score ("0", "15", "30", "40", "Deuce", "Ad")
 }
 
 // ScoreCount represents a simple numeric score (games or sets).
 type ScoreCount struct {
-	A int // Team A's count
-	B int // Team B's count
+	A int `json:"a"` // Team A's count
+	B int `json:"b"` // Team B's count
 }
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+)
+
+// ExportFormat is the file format of a match export.
+type ExportFormat string
+
+const (
+	ExportCSV    ExportFormat = "csv"
+	ExportJSON   ExportFormat = "json"
+	ExportNDJSON ExportFormat = "ndjson"
+)
+
+// ErrInvalidExportFormat is returned for an unknown export format.
+var ErrInvalidExportFormat = errors.New("format must be csv, json or ndjson")
+
+// ParseExportFormat validates an export format name.
+func ParseExportFormat(name string) (ExportFormat, error) {
+	switch format := ExportFormat(name); format {
+	case ExportCSV, ExportJSON, ExportNDJSON:
+		return format, nil
+	}
+	return "", ErrInvalidExportFormat
+}
+
+// ContentType returns the MIME type of the format.
+func (f ExportFormat) ContentType() string {
+	switch f {
+	case ExportCSV:
+		return "text/csv; charset=utf-8"
+	case ExportNDJSON:
+		return "application/x-ndjson"
+	}
+	return "application/json"
+}
+
+// exportColumns is the CSV layout: one row per point, with the match it
+// belongs to repeated on every row. A match without points has a single row
+// with the point columns empty. Columns are only ever appended.
+var exportColumns = []string{
+	"match_id", "venue_id", "venue_name", "court_id", "match_type", "mode", "status",
+	"started_at", "ended_at", "team_a", "team_b", "winner_team", "score",
+	"points_a", "points_b",
+	"seq", "event_id", "timestamp", "server_player_id", "server_name", "serve_type", "point_winner_team",
+}
+
+// ExportMatches writes every match matching a filter, newest first, with its
+// point events and computed stats. Matches are streamed from the database
+// in batches; an error after the first match leaves the output truncated.
+func (s *MatchService) ExportMatches(ctx context.Context, filter MatchHistoryFilter, format ExportFormat, w io.Writer) error {
+	players, err := s.playerRepo.List(ctx, false)
+	if err != nil {
+		return err
+	}
+	names := make(map[uuid.UUID]string, len(players))
+	for _, p := range players {
+		names[p.ID] = p.Name
+	}
+
+	venues, err := s.venueRepo.List(ctx, false)
+	if err != nil {
+		return err
+	}
+	venueNames := make(map[uuid.UUID]string, len(venues))
+	for _, v := range venues {
+		venueNames[v.ID] = v.Name
+	}
+
+	var write func(*model.MatchExport) error
+	var finish func() error
+
+	switch format {
+	case ExportCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(exportColumns); err != nil {
+			return err
+		}
+		write = func(e *model.MatchExport) error {
+			for _, row := range exportCSVRows(e, names) {
+				if err := cw.Write(row); err != nil {
+					return err
+				}
+			}
+			cw.Flush()
+			return cw.Error()
+		}
+		finish = func() error { return nil }
+
+	case ExportJSON:
+		enc := json.NewEncoder(w)
+		first := true
+		if _, err := io.WriteString(w, "["); err != nil {
+			return err
+		}
+		write = func(e *model.MatchExport) error {
+			if !first {
+				if _, err := io.WriteString(w, ","); err != nil {
+					return err
+				}
+			}
+			first = false
+			return enc.Encode(e)
+		}
+		finish = func() error {
+			_, err := io.WriteString(w, "]\n")
+			return err
+		}
+
+	case ExportNDJSON:
+		enc := json.NewEncoder(w)
+		write = func(e *model.MatchExport) error { return enc.Encode(e) }
+		finish = func() error { return nil }
+
+	default:
+		return ErrInvalidExportFormat
+	}
+
+	err = s.matchRepo.StreamMatches(ctx, repository.MatchFilter(filter), func(match model.Match, matchPlayers []model.MatchPlayer, events []model.PointEvent) error {
+		export, err := buildMatchExport(match, matchPlayers, events, names)
+		if err != nil {
+			return err
+		}
+		export.VenueName = venueNames[match.VenueID]
+		return write(export)
+	})
+	if err != nil {
+		return err
+	}
+	return finish()
+}
+
+// buildMatchExport computes the stats of a match for export.
+func buildMatchExport(match model.Match, players []model.MatchPlayer, events []model.PointEvent, names map[uuid.UUID]string) (*model.MatchExport, error) {
+	stats, err := computeMatchStats(&match, players, names, events)
+	if err != nil {
+		return nil, err
+	}
+	playerStats := stats.players
+	if playerStats == nil {
+		playerStats = []model.PlayerMatchStats{}
+	}
+	if events == nil {
+		events = []model.PointEvent{}
+	}
+
+	sets := stats.replay.sets
+	if match.ResultOnly {
+		sets = resultSets(&match)
+	} else if match.Status == model.MatchStatusCompleted && match.Score == "" {
+		// Results not yet backfilled come from the points
+		match.WinnerTeam, match.Score = matchResult(stats.replay)
+	}
+
+	return &model.MatchExport{
+		Match:   match,
+		Players: playerStats,
+		Teams:   stats.teams,
+		Sets:    sets,
+		PointsA: stats.pointsA,
+		PointsB: stats.pointsB,
+		Events:  events,
+	}, nil
+}
+
+// exportCSVRows lays out a match as rows matching exportColumns.
+func exportCSVRows(e *model.MatchExport, names map[uuid.UUID]string) [][]string {
+	var teamA, teamB []string
+	for _, p := range e.Players {
+		if p.Team == model.TeamA {
+			teamA = append(teamA, p.PlayerName)
+		} else {
+			teamB = append(teamB, p.PlayerName)
+		}
+	}
+
+	m := e.Match
+	courtID := ""
+	if m.CourtID != nil {
+		courtID = m.CourtID.String()
+	}
+	endedAt := ""
+	if m.EndedAt != nil {
+		endedAt = m.EndedAt.UTC().Format(time.RFC3339)
+	}
+	winner := ""
+	if m.WinnerTeam != nil {
+		winner = string(*m.WinnerTeam)
+	}
+
+	match := []string{
+		m.ID.String(),
+		m.VenueID.String(),
+		e.VenueName,
+		courtID,
+		string(m.MatchType),
+		string(m.Mode),
+		string(m.Status),
+		m.StartedAt.UTC().Format(time.RFC3339),
+		endedAt,
+		strings.Join(teamA, " / "),
+		strings.Join(teamB, " / "),
+		winner,
+		m.Score,
+		strconv.Itoa(e.PointsA),
+		strconv.Itoa(e.PointsB),
+	}
+
+	if len(e.Events) == 0 {
+		return [][]string{append(match, make([]string, len(exportColumns)-len(match))...)}
+	}
+
+	rows := make([][]string, 0, len(e.Events))
+	for _, ev := range e.Events {
+		row := append(append([]string{}, match...),
+			strconv.Itoa(ev.Seq),
+			ev.ID.String(),
+			ev.Timestamp.UTC().Format(time.RFC3339Nano),
+			ev.ServerPlayerID.String(),
+			names[ev.ServerPlayerID],
+			string(ev.ServeType),
+			string(ev.PointWinnerTeam),
+		)
+		rows = append(rows, row)
+	}
+	return rows
+}
+
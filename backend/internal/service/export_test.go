@@ -0,0 +1,70 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+)
+
+func TestBuildMatchExport(t *testing.T) {
+	match, players := newTestMatch(model.MatchModeStandard)
+	server := players[0].PlayerID
+	names := map[uuid.UUID]string{players[0].PlayerID: "Asha", players[1].PlayerID: "Ben"}
+
+	events := pointEvents(match.ID, server, time.Now(), strings.Repeat("AAAA", 6)+"B")
+	export, err := buildMatchExport(*match, players, events, names)
+	if err != nil {
+		t.Fatalf("buildMatchExport: %v", err)
+	}
+
+	if export.PointsA != 24 || export.PointsB != 1 {
+		t.Errorf("expected 24-1 in points, got %d-%d", export.PointsA, export.PointsB)
+	}
+	if len(export.Sets) != 1 || export.Sets[0].GamesA != 6 {
+		t.Errorf("expected one 6-0 set, got %+v", export.Sets)
+	}
+	if export.Players[0].PlayerName != "Asha" || export.Players[0].FirstServesIn != 25 {
+		t.Errorf("unexpected stats for Asha: %+v", export.Players[0])
+	}
+
+	rows := exportCSVRows(export, names)
+	if len(rows) != len(events) {
+		t.Fatalf("expected a row per point, got %d", len(rows))
+	}
+	for _, row := range rows {
+		if len(row) != len(exportColumns) {
+			t.Fatalf("expected %d columns, got %d", len(exportColumns), len(row))
+		}
+	}
+	last := rows[len(rows)-1]
+	if last[9] != "Asha" || last[10] != "Ben" || last[15] != "25" || last[19] != "Asha" || last[21] != "B" {
+		t.Errorf("unexpected last row: %v", last)
+	}
+}
+
+func TestExportCSVRowsWithoutPoints(t *testing.T) {
+	match, players := newTestMatch(model.MatchModeStandard)
+	export, err := buildMatchExport(*match, players, nil, nil)
+	if err != nil {
+		t.Fatalf("buildMatchExport: %v", err)
+	}
+
+	rows := exportCSVRows(export, nil)
+	if len(rows) != 1 || len(rows[0]) != len(exportColumns) || rows[0][15] != "" {
+		t.Errorf("expected a single row with empty point columns, got %v", rows)
+	}
+}
+
+func TestParseExportFormat(t *testing.T) {
+	for _, name := range []string{"csv", "json", "ndjson"} {
+		if _, err := ParseExportFormat(name); err != nil {
+			t.Errorf("ParseExportFormat(%q): %v", name, err)
+		}
+	}
+	if _, err := ParseExportFormat("xlsx"); err != ErrInvalidExportFormat {
+		t.Errorf("expected ErrInvalidExportFormat, got %v", err)
+	}
+}
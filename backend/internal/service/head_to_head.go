@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+)
+
+// ErrInvalidSide is returned for a head-to-head side that isn't a player ID
+// or a pair of player IDs.
+var ErrInvalidSide = errors.New("side must be a player id or two player ids joined by ':'")
+
+// HeadToHeadFilter limits the matches counted in a head-to-head.
+type HeadToHeadFilter struct {
+	VenueID *uuid.UUID
+	Surface *model.Surface
+	From    *time.Time
+	To      *time.Time
+}
+
+// GetHeadToHead returns the record between sides a and b over their
+// completed matches. A side is a player ID or a doubles pair in the
+// "id1:id2" form of formatTeamID, in either order.
+func (s *MatchService) GetHeadToHead(ctx context.Context, a, b string, filter HeadToHeadFilter) (*model.HeadToHead, error) {
+	aIDs, err := parseSideKey(a)
+	if err != nil {
+		return nil, err
+	}
+	bIDs, err := parseSideKey(b)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range aIDs {
+		if containsID(bIDs, id) {
+			return nil, fmt.Errorf("%w: player %s is on both sides", ErrInvalidSide, id)
+		}
+	}
+
+	result := &model.HeadToHead{Matches: []model.HeadToHeadMatch{}}
+	sides := []*model.HeadToHeadSide{&result.A, &result.B}
+	for i, ids := range [][]uuid.UUID{aIDs, bIDs} {
+		side := sides[i]
+		side.Key = sideKey(ids)
+		side.PlayerIDs = ids
+		for _, id := range ids {
+			player, err := s.playerRepo.GetByID(ctx, id)
+			if err != nil {
+				return nil, fmt.Errorf("player not found: %w", err)
+			}
+			side.PlayerNames = append(side.PlayerNames, player.Name)
+		}
+	}
+
+	repoFilter := repository.MatchFilter{
+		VenueID:    filter.VenueID,
+		PlayerID:   &aIDs[0],
+		OpponentID: &bIDs[0],
+		Status:     model.MatchStatusCompleted,
+		Surface:    filter.Surface,
+		From:       filter.From,
+		To:         filter.To,
+	}
+
+	var serve [2]serveTotals
+	err = s.matchRepo.StreamMatches(ctx, repoFilter, func(match model.Match, players []model.MatchPlayer, events []model.PointEvent) error {
+		aTeam, ok := teamOfSide(players, aIDs)
+		if !ok {
+			return nil
+		}
+		bTeam, ok := teamOfSide(players, bIDs)
+		if !ok || bTeam == aTeam {
+			return nil
+		}
+
+		entry := model.HeadToHeadMatch{
+			MatchID:    match.ID,
+			VenueID:    match.VenueID,
+			MatchType:  match.MatchType,
+			Mode:       match.Mode,
+			StartedAt:  match.StartedAt,
+			Score:      match.Score,
+			ResultOnly: match.ResultOnly,
+		}
+		if aTeam == model.TeamB {
+			entry.Score = flipScore(match.Score)
+		}
+		if match.WinnerTeam != nil {
+			if *match.WinnerTeam == aTeam {
+				entry.Winner = "a"
+				result.A.Wins++
+				result.B.Losses++
+			} else {
+				entry.Winner = "b"
+				result.B.Wins++
+				result.A.Losses++
+			}
+		}
+
+		var setsA, setsB, gamesA, gamesB int
+		if match.ResultOnly {
+			score, err := storedScore(&match)
+			if err != nil {
+				return fmt.Errorf("invalid stored score for match %s: %w", match.ID, err)
+			}
+			gamesA, gamesB = score.GamesWon()
+			if match.Mode != model.MatchModeShort {
+				setsA, setsB = score.SetsWon()
+			}
+		} else {
+			stats, err := computeMatchStats(&match, players, nil, events)
+			if err != nil {
+				return err
+			}
+			setsA, setsB = stats.replay.state.SetsA, stats.replay.state.SetsB
+			gamesA, gamesB = stats.gamesA, stats.gamesB
+			for i, team := range []model.Team{aTeam, bTeam} {
+				serve[i].add(stats.players, team)
+			}
+		}
+
+		if aTeam == model.TeamB {
+			setsA, setsB = setsB, setsA
+			gamesA, gamesB = gamesB, gamesA
+		}
+		result.A.SetsWon += setsA
+		result.B.SetsWon += setsB
+		result.A.GamesWon += gamesA
+		result.B.GamesWon += gamesB
+
+		result.Matches = append(result.Matches, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result.A.Serve = serve[0].averages()
+	result.B.Serve = serve[1].averages()
+	return result, nil
+}
+
+// serveTotals sums the serve statistics of a side or a single player over
+// several matches.
+type serveTotals struct {
+	matches                          int
+	firstIn, firstTotal, firstWon    int
+	secondIn, secondTotal, secondWon int
+	doubleFaults                     int
+}
+
+// add counts the serve statistics of the given team's players in one match.
+// Matches without recorded serves, such as imports, are skipped.
+func (t *serveTotals) add(players []model.PlayerMatchStats, team model.Team) {
+	var match serveTotals
+	for _, ps := range players {
+		if ps.Team != team {
+			continue
+		}
+		match.firstIn += ps.FirstServesIn
+		match.firstTotal += ps.FirstServesTotal
+		match.firstWon += ps.FirstServeWon
+		match.secondIn += ps.SecondServesIn
+		match.secondTotal += ps.SecondServesTotal
+		match.secondWon += ps.SecondServeWon
+		match.doubleFaults += ps.DoubleFaults
+	}
+	if match.firstTotal == 0 {
+		return
+	}
+
+	t.matches++
+	t.firstIn += match.firstIn
+	t.firstTotal += match.firstTotal
+	t.firstWon += match.firstWon
+	t.secondIn += match.secondIn
+	t.secondTotal += match.secondTotal
+	t.secondWon += match.secondWon
+	t.doubleFaults += match.doubleFaults
+}
+
+func (t *serveTotals) averages() model.ServeAverages {
+	serve := model.ServeAverages{Matches: t.matches}
+	if t.matches == 0 {
+		return serve
+	}
+	serve.DoubleFaultsPerMatch = float64(t.doubleFaults) / float64(t.matches)
+	if t.firstTotal > 0 {
+		serve.FirstServeInPct = float64(t.firstIn) / float64(t.firstTotal) * 100
+	}
+	if t.firstIn > 0 {
+		serve.FirstServePointsWonPct = float64(t.firstWon) / float64(t.firstIn) * 100
+	}
+	if t.secondTotal > 0 {
+		serve.SecondServeInPct = float64(t.secondIn) / float64(t.secondTotal) * 100
+	}
+	if t.secondIn > 0 {
+		serve.SecondServePointsWonPct = float64(t.secondWon) / float64(t.secondIn) * 100
+	}
+	return serve
+}
+
+// parseSideKey parses a player ID or a "id1:id2" doubles pair.
+func parseSideKey(key string) ([]uuid.UUID, error) {
+	parts := strings.Split(key, ":")
+	if len(parts) > 2 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidSide, key)
+	}
+	ids := make([]uuid.UUID, len(parts))
+	for i, part := range parts {
+		id, err := uuid.Parse(part)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidSide, key)
+		}
+		ids[i] = id
+	}
+	if len(ids) == 2 {
+		if ids[0] == ids[1] {
+			return nil, fmt.Errorf("%w: %q names the same player twice", ErrInvalidSide, key)
+		}
+		if ids[1].String() < ids[0].String() {
+			ids[0], ids[1] = ids[1], ids[0]
+		}
+	}
+	return ids, nil
+}
+
+// sideKey returns the key of a side: the player ID, or formatTeamID for a pair.
+func sideKey(ids []uuid.UUID) string {
+	if len(ids) == 2 {
+		return formatTeamID(ids[0], ids[1])
+	}
+	return ids[0].String()
+}
+
+// teamOfSide returns the team made up of exactly the given players.
+func teamOfSide(players []model.MatchPlayer, ids []uuid.UUID) (model.Team, bool) {
+	var team model.Team
+	for _, mp := range players {
+		if containsID(ids, mp.PlayerID) {
+			if team != "" && team != mp.Team {
+				return "", false
+			}
+			team = mp.Team
+		}
+	}
+	if team == "" {
+		return "", false
+	}
+
+	size := 0
+	for _, mp := range players {
+		if mp.Team == team {
+			if !containsID(ids, mp.PlayerID) {
+				return "", false
+			}
+			size++
+		}
+	}
+	return team, size == len(ids)
+}
+
+func containsID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, other := range ids {
+		if other == id {
+			return true
+		}
+	}
+	return false
+}
+
+// flipScore returns a score from Team B's side, e.g. "6-4 6-7(3)" becomes
+// "4-6 7-6(3)". Tie-break points belong to the set's loser and stay as they
+// are.
+func flipScore(score string) string {
+	sets := strings.Fields(score)
+	for i, set := range sets {
+		games, tieBreak := set, ""
+		if idx := strings.Index(set, "("); idx >= 0 {
+			games, tieBreak = set[:idx], set[idx:]
+		}
+		if a, b, ok := strings.Cut(games, "-"); ok {
+			sets[i] = b + "-" + a + tieBreak
+		}
+	}
+	return strings.Join(sets, " ")
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+)
+
+func TestParseSideKey(t *testing.T) {
+	p1, p2 := uuid.New(), uuid.New()
+
+	ids, err := parseSideKey(p1.String())
+	if err != nil || len(ids) != 1 || ids[0] != p1 {
+		t.Errorf("expected a single player, got %v, %v", ids, err)
+	}
+
+	// Either order gives the same key as venue tendencies
+	for _, key := range []string{p1.String() + ":" + p2.String(), p2.String() + ":" + p1.String()} {
+		ids, err := parseSideKey(key)
+		if err != nil {
+			t.Fatalf("parseSideKey(%q): %v", key, err)
+		}
+		if got := sideKey(ids); got != formatTeamID(p1, p2) {
+			t.Errorf("expected key %s, got %s", formatTeamID(p1, p2), got)
+		}
+	}
+
+	for _, key := range []string{"", "nope", p1.String() + ":" + p1.String(), p1.String() + ":" + p2.String() + ":" + p1.String()} {
+		if _, err := parseSideKey(key); !errors.Is(err, ErrInvalidSide) {
+			t.Errorf("parseSideKey(%q): expected ErrInvalidSide, got %v", key, err)
+		}
+	}
+}
+
+func TestTeamOfSide(t *testing.T) {
+	a1, a2, b1, b2 := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	doubles := []model.MatchPlayer{
+		{PlayerID: a1, Team: model.TeamA},
+		{PlayerID: a2, Team: model.TeamA},
+		{PlayerID: b1, Team: model.TeamB},
+		{PlayerID: b2, Team: model.TeamB},
+	}
+
+	if team, ok := teamOfSide(doubles, []uuid.UUID{b2, b1}); !ok || team != model.TeamB {
+		t.Errorf("expected the pair on team B, got %s %v", team, ok)
+	}
+	// A player with a partner isn't the same side as the player alone
+	if _, ok := teamOfSide(doubles, []uuid.UUID{a1}); ok {
+		t.Error("expected a single player not to match a doubles side")
+	}
+	// Players split across the net aren't a side
+	if _, ok := teamOfSide(doubles, []uuid.UUID{a1, b1}); ok {
+		t.Error("expected opponents not to match a side")
+	}
+
+	australian := doubles[1:]
+	if team, ok := teamOfSide(australian, []uuid.UUID{a2}); !ok || team != model.TeamA {
+		t.Errorf("expected the lone player on team A, got %s %v", team, ok)
+	}
+}
+
+func TestFlipScore(t *testing.T) {
+	tests := map[string]string{
+		"6-4 3-6 7-6(5)": "4-6 6-3 6-7(5)",
+		"2-1":            "1-2",
+		"":               "",
+	}
+	for score, want := range tests {
+		if got := flipScore(score); got != want {
+			t.Errorf("flipScore(%q) = %q, want %q", score, got, want)
+		}
+	}
+}
+
+func TestServeTotalsAverages(t *testing.T) {
+	var totals serveTotals
+	totals.add([]model.PlayerMatchStats{
+		{Team: model.TeamA, FirstServesTotal: 10, FirstServesIn: 6, FirstServeWon: 3, SecondServesTotal: 4, SecondServesIn: 3, SecondServeWon: 1, DoubleFaults: 1},
+		{Team: model.TeamB, FirstServesTotal: 10, FirstServesIn: 10, FirstServeWon: 10},
+	}, model.TeamA)
+	totals.add([]model.PlayerMatchStats{
+		{Team: model.TeamA, FirstServesTotal: 10, FirstServesIn: 4, FirstServeWon: 3, SecondServesTotal: 6, SecondServesIn: 3, SecondServeWon: 2, DoubleFaults: 3},
+	}, model.TeamA)
+	// An import without serve data doesn't count
+	totals.add([]model.PlayerMatchStats{{Team: model.TeamA}}, model.TeamA)
+
+	serve := totals.averages()
+	if serve.Matches != 2 {
+		t.Errorf("expected 2 matches, got %d", serve.Matches)
+	}
+	if serve.FirstServeInPct != 50 || serve.FirstServePointsWonPct != 60 {
+		t.Errorf("expected first serves 50%% in and 60%% won, got %+v", serve)
+	}
+	if serve.SecondServeInPct != 60 || serve.SecondServePointsWonPct != 50 {
+		t.Errorf("expected second serves 60%% in and 50%% won, got %+v", serve)
+	}
+	if serve.DoubleFaultsPerMatch != 2 {
+		t.Errorf("expected 2 double faults per match, got %.2f", serve.DoubleFaultsPerMatch)
+	}
+}
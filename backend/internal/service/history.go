@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/scoring"
+)
+
+// ErrInvalidCursor is returned for a history cursor the server did not issue.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// MatchHistoryFilter narrows the match history. Zero fields are not
+// filtered on. PartnerID and OpponentID are relative to PlayerID.
+type MatchHistoryFilter struct {
+	VenueID    *uuid.UUID
+	PlayerID   *uuid.UUID
+	PartnerID  *uuid.UUID
+	OpponentID *uuid.UUID
+	MatchType  model.MatchType
+	Mode       model.MatchMode
+	Status     model.MatchStatus
+	Surface    *model.Surface
+	From       *time.Time
+	To         *time.Time
+}
+
+// ListMatches returns a page of the match history, newest first. cursor is
+// the NextCursor of the previous page, or empty for the first page.
+func (s *MatchService) ListMatches(ctx context.Context, filter MatchHistoryFilter, cursor string, limit int) (*model.MatchHistory, error) {
+	var after *repository.MatchCursor
+	if cursor != "" {
+		c, err := decodeMatchCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		after = &c
+	}
+
+	// Fetch one extra match to know whether there is a next page
+	matches, err := s.matchRepo.ListHistory(ctx, repository.MatchFilter(filter), after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	history := &model.MatchHistory{Matches: []model.MatchHistoryEntry{}}
+	if len(matches) > limit {
+		matches = matches[:limit]
+		last := matches[limit-1]
+		history.NextCursor = encodeMatchCursor(repository.MatchCursor{StartedAt: last.StartedAt, ID: last.ID})
+	}
+
+	ids := make([]uuid.UUID, len(matches))
+	for i, m := range matches {
+		ids[i] = m.ID
+	}
+	players, err := s.matchRepo.GetPlayersForMatches(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range matches {
+		entry := model.MatchHistoryEntry{Match: m, Players: players[m.ID]}
+		if entry.Players == nil {
+			entry.Players = []model.MatchPlayer{}
+		}
+		history.Matches = append(history.Matches, entry)
+	}
+
+	return history, nil
+}
+
+// BackfillResults stores the result of completed matches that have none,
+// such as those completed before results were stored, and rebuilds the
+// ratings if any were stored. It returns the number of matches given a result.
+func (s *MatchService) BackfillResults(ctx context.Context) (int, error) {
+	matches, err := s.matchRepo.ListCompletedWithoutResult(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	stored := 0
+	for i := range matches {
+		if err := s.storeResult(ctx, &matches[i]); err != nil {
+			return stored, fmt.Errorf("match %s: %w", matches[i].ID, err)
+		}
+		if matches[i].Score != "" {
+			stored++
+		}
+	}
+
+	if stored > 0 {
+		if err := s.ratings.Recompute(ctx); err != nil {
+			return stored, err
+		}
+	}
+	return stored, nil
+}
+
+// storeResult replays a match and stores its winner and scoreline on it.
+func (s *MatchService) storeResult(ctx context.Context, match *model.Match) error {
+	// Result-only matches are stored with theirs and have no points to replay
+	if match.ResultOnly {
+		return nil
+	}
+
+	replay, err := s.loadReplay(ctx, match.ID)
+	if err != nil {
+		return err
+	}
+
+	winner, score := matchResult(replay)
+	if score == "" {
+		return nil
+	}
+	if err := s.matchRepo.SetResult(ctx, match.ID, winner, score); err != nil {
+		return err
+	}
+
+	match.WinnerTeam = winner
+	match.Score = score
+	return nil
+}
+
+// matchResult returns the winner and scoreline of a replayed match, from
+// Team A's side. Standard matches list the games of each set, with any
+// tie-break points, including an unfinished last set; short-format matches
+// give the games won. A match stopped before it was decided is won by
+// whoever leads on sets, then games, and has no winner if level.
+func matchResult(r *matchReplay) (*model.Team, string) {
+	st := r.state
+
+	var parts []string
+	if st.Mode == scoring.ModeShortFormat {
+		if st.GamesA+st.GamesB > 0 {
+			parts = append(parts, fmt.Sprintf("%d-%d", st.GamesA, st.GamesB))
+		}
+	} else {
+		for _, set := range r.sets {
+			parts = append(parts, set.String())
+		}
+		if !st.Completed && st.GamesA+st.GamesB > 0 {
+			parts = append(parts, fmt.Sprintf("%d-%d", st.GamesA, st.GamesB))
+		}
+	}
+
+	var winner *model.Team
+	switch {
+	case st.Winner != nil:
+		team := model.Team(*st.Winner)
+		winner = &team
+	case st.SetsA != st.SetsB:
+		team := model.TeamA
+		if st.SetsB > st.SetsA {
+			team = model.TeamB
+		}
+		winner = &team
+	case st.GamesA != st.GamesB:
+		team := model.TeamA
+		if st.GamesB > st.GamesA {
+			team = model.TeamB
+		}
+		winner = &team
+	}
+
+	return winner, strings.Join(parts, " ")
+}
+
+// encodeMatchCursor makes an opaque cursor from a history position.
+func encodeMatchCursor(c repository.MatchCursor) string {
+	raw := strconv.FormatInt(c.StartedAt.UnixNano(), 10) + "_" + c.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeMatchCursor parses a cursor made by encodeMatchCursor.
+func decodeMatchCursor(cursor string) (repository.MatchCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return repository.MatchCursor{}, ErrInvalidCursor
+	}
+
+	nanos, id, ok := strings.Cut(string(raw), "_")
+	if !ok {
+		return repository.MatchCursor{}, ErrInvalidCursor
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return repository.MatchCursor{}, ErrInvalidCursor
+	}
+	matchID, err := uuid.Parse(id)
+	if err != nil {
+		return repository.MatchCursor{}, ErrInvalidCursor
+	}
+
+	return repository.MatchCursor{StartedAt: time.Unix(0, n), ID: matchID}, nil
+}
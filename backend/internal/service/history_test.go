@@ -0,0 +1,66 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+)
+
+func replayPoints(t *testing.T, mode model.MatchMode, seq string) *matchReplay {
+	t.Helper()
+	match, players := newTestMatch(mode)
+	replay, err := newMatchReplay(match, players)
+	if err != nil {
+		t.Fatalf("newMatchReplay: %v", err)
+	}
+	for _, e := range pointEvents(match.ID, players[0].PlayerID, time.Now(), seq) {
+		replay.apply(e)
+	}
+	return replay
+}
+
+func TestMatchResult(t *testing.T) {
+	// Decided in straight sets
+	winner, score := matchResult(replayPoints(t, model.MatchModeStandard, strings.Repeat("AAAA", 12)))
+	if winner == nil || *winner != model.TeamA || score != "6-0 6-0" {
+		t.Errorf("expected A to win 6-0 6-0, got %v %q", winner, score)
+	}
+
+	// Stopped in the second set: B leads on sets despite trailing in games
+	winner, score = matchResult(replayPoints(t, model.MatchModeStandard, strings.Repeat("BBBB", 6)+strings.Repeat("AAAA", 2)))
+	if winner == nil || *winner != model.TeamB || score != "0-6 2-0" {
+		t.Errorf("expected B to lead 0-6 2-0, got %v %q", winner, score)
+	}
+
+	// No points, no result
+	winner, score = matchResult(replayPoints(t, model.MatchModeStandard, ""))
+	if winner != nil || score != "" {
+		t.Errorf("expected no result, got %v %q", winner, score)
+	}
+}
+
+func TestMatchCursorRoundTrip(t *testing.T) {
+	want := repository.MatchCursor{
+		StartedAt: time.Date(2024, 6, 1, 10, 30, 0, 123000, time.UTC),
+		ID:        uuid.New(),
+	}
+
+	got, err := decodeMatchCursor(encodeMatchCursor(want))
+	if err != nil {
+		t.Fatalf("decodeMatchCursor: %v", err)
+	}
+	if !got.StartedAt.Equal(want.StartedAt) || got.ID != want.ID {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+
+	for _, bad := range []string{"not base64!", "bm90LWEtY3Vyc29y"} {
+		if _, err := decodeMatchCursor(bad); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("expected ErrInvalidCursor for %q, got %v", bad, err)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+)
+
+const (
+	// liveHistorySize is how many recent updates are kept per match and per
+	// venue so reconnecting clients can resume from Last-Event-ID.
+	liveHistorySize = 64
+
+	// liveSubscriberBuffer is the per-subscriber queue length. Subscribers
+	// that fall further behind are dropped and expected to reconnect.
+	liveSubscriberBuffer = 16
+
+	// liveHistoryIdle is how long a match's history is kept without new
+	// updates or followers, for matches that are never completed.
+	liveHistoryIdle = 6 * time.Hour
+
+	// liveHistorySweepInterval is how often idle histories are looked for.
+	liveHistorySweepInterval = 10 * time.Minute
+)
+
+// LiveSubscription receives live updates for a match or venue.
+type LiveSubscription struct {
+	// C delivers updates; it is closed when the subscription ends
+	C <-chan model.LiveUpdate
+
+	ch      chan model.LiveUpdate
+	matchID uuid.UUID
+	venueID uuid.UUID
+	closed  bool
+}
+
+// liveHub fans out live updates to match and venue subscribers.
+type liveHub struct {
+	mu     sync.Mutex
+	nextID uint64
+
+	matchSubs map[uuid.UUID]map[*LiveSubscription]bool
+	venueSubs map[uuid.UUID]map[*LiveSubscription]bool
+
+	matchHistory map[uuid.UUID][]model.LiveUpdate
+	venueHistory map[uuid.UUID][]model.LiveUpdate
+
+	// lastPublished is when each match with history last had an update.
+	// ended marks matches that completed or were abandoned; their history
+	// goes when the last follower leaves.
+	lastPublished map[uuid.UUID]time.Time
+	ended         map[uuid.UUID]bool
+	lastSweep     time.Time
+
+	// forgottenAt is the last update ID when a match history was dropped
+	forgottenAt uint64
+
+	// onForget, if set, is called with h.mu held when a match history is
+	// dropped, so state kept alongside it can go too
+	onForget func(matchID uuid.UUID)
+}
+
+func newLiveHub() *liveHub {
+	return &liveHub{
+		matchSubs:     make(map[uuid.UUID]map[*LiveSubscription]bool),
+		venueSubs:     make(map[uuid.UUID]map[*LiveSubscription]bool),
+		matchHistory:  make(map[uuid.UUID][]model.LiveUpdate),
+		venueHistory:  make(map[uuid.UUID][]model.LiveUpdate),
+		lastPublished: make(map[uuid.UUID]time.Time),
+		ended:         make(map[uuid.UUID]bool),
+		lastSweep:     time.Now(),
+	}
+}
+
+// publish assigns the next update ID and delivers the update to everyone
+// following the match or its venue.
+func (h *liveHub) publish(updateType model.LiveUpdateType, state *model.LiveMatchState) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.nextID++
+	update := model.LiveUpdate{ID: h.nextID, Type: updateType, State: state}
+
+	now := time.Now()
+	h.matchHistory[state.MatchID] = appendHistory(h.matchHistory[state.MatchID], update)
+	h.venueHistory[state.VenueID] = appendHistory(h.venueHistory[state.VenueID], update)
+	h.lastPublished[state.MatchID] = now
+
+	for sub := range h.matchSubs[state.MatchID] {
+		h.deliver(sub, update)
+	}
+	for sub := range h.venueSubs[state.VenueID] {
+		h.deliver(sub, update)
+	}
+
+	// Finished matches no longer need resume history once nobody follows them
+	if updateType == model.LiveUpdateMatchCompleted || updateType == model.LiveUpdateMatchAbandoned {
+		h.ended[state.MatchID] = true
+		if len(h.matchSubs[state.MatchID]) == 0 {
+			h.forgetMatch(state.MatchID)
+		}
+	}
+	h.sweep(now)
+}
+
+// forget drops the resume history of a match, e.g. once it is deleted.
+func (h *liveHub) forget(matchID uuid.UUID) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.forgetMatch(matchID)
+}
+
+// forgetMatch drops the resume history of a match. Caller holds h.mu.
+func (h *liveHub) forgetMatch(matchID uuid.UUID) {
+	delete(h.matchHistory, matchID)
+	delete(h.lastPublished, matchID)
+	delete(h.ended, matchID)
+	h.forgottenAt = h.nextID
+	if h.onForget != nil {
+		h.onForget(matchID)
+	}
+}
+
+// sweep drops the history of matches that have had no updates for
+// liveHistoryIdle and have no followers, such as matches left in progress.
+// It runs at most once per liveHistorySweepInterval. Caller holds h.mu.
+func (h *liveHub) sweep(now time.Time) {
+	if now.Sub(h.lastSweep) < liveHistorySweepInterval {
+		return
+	}
+	h.lastSweep = now
+
+	for matchID, published := range h.lastPublished {
+		if now.Sub(published) > liveHistoryIdle && len(h.matchSubs[matchID]) == 0 {
+			h.forgetMatch(matchID)
+		}
+	}
+}
+
+// subscribeMatch follows a single match. If lastEventID can be resumed from
+// history, the missed updates are returned and resumed is true.
+func (h *liveHub) subscribeMatch(matchID uuid.UUID, lastEventID uint64) (sub *LiveSubscription, missed []model.LiveUpdate, resumed bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sub = newLiveSubscription(matchID, uuid.Nil)
+	if h.matchSubs[matchID] == nil {
+		h.matchSubs[matchID] = make(map[*LiveSubscription]bool)
+	}
+	h.matchSubs[matchID][sub] = true
+
+	missed, resumed = h.missedSince(h.matchHistory[matchID], lastEventID)
+	return sub, missed, resumed
+}
+
+// subscribeVenue follows every match at a venue.
+func (h *liveHub) subscribeVenue(venueID uuid.UUID, lastEventID uint64) (sub *LiveSubscription, missed []model.LiveUpdate, resumed bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sub = newLiveSubscription(uuid.Nil, venueID)
+	if h.venueSubs[venueID] == nil {
+		h.venueSubs[venueID] = make(map[*LiveSubscription]bool)
+	}
+	h.venueSubs[venueID][sub] = true
+
+	missed, resumed = h.missedSince(h.venueHistory[venueID], lastEventID)
+	return sub, missed, resumed
+}
+
+// cursor returns the ID of the most recent update. Anything published
+// later has a larger ID.
+func (h *liveHub) cursor() uint64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.nextID
+}
+
+// unsubscribe ends a subscription and closes its channel.
+func (h *liveHub) unsubscribe(sub *LiveSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.remove(sub)
+}
+
+// missedSince returns the updates after lastEventID. Resume is only possible
+// when the retained history still covers everything since that ID; otherwise
+// the caller should send a fresh snapshot. Caller holds h.mu.
+func (h *liveHub) missedSince(history []model.LiveUpdate, lastEventID uint64) ([]model.LiveUpdate, bool) {
+	if lastEventID == 0 || lastEventID > h.nextID {
+		return nil, false
+	}
+
+	// No history means nothing was published since lastEventID, unless a
+	// history was dropped after it
+	if len(history) == 0 {
+		return nil, lastEventID >= h.forgottenAt
+	}
+
+	// A full history may have dropped updates newer than lastEventID
+	if len(history) == liveHistorySize && history[0].ID > lastEventID {
+		return nil, false
+	}
+
+	var missed []model.LiveUpdate
+	for _, update := range history {
+		if update.ID > lastEventID {
+			missed = append(missed, update)
+		}
+	}
+	return missed, true
+}
+
+// deliver queues an update without blocking. Caller holds h.mu.
+func (h *liveHub) deliver(sub *LiveSubscription, update model.LiveUpdate) {
+	select {
+	case sub.ch <- update:
+	default:
+		// Too slow: drop the subscriber, it will reconnect and resume
+		h.remove(sub)
+	}
+}
+
+// remove detaches a subscription. Caller holds h.mu.
+func (h *liveHub) remove(sub *LiveSubscription) {
+	if sub.closed {
+		return
+	}
+	sub.closed = true
+	close(sub.ch)
+
+	if sub.matchID != uuid.Nil {
+		delete(h.matchSubs[sub.matchID], sub)
+		if len(h.matchSubs[sub.matchID]) == 0 {
+			delete(h.matchSubs, sub.matchID)
+			if h.ended[sub.matchID] {
+				h.forgetMatch(sub.matchID)
+			}
+		}
+	}
+	if sub.venueID != uuid.Nil {
+		delete(h.venueSubs[sub.venueID], sub)
+		if len(h.venueSubs[sub.venueID]) == 0 {
+			delete(h.venueSubs, sub.venueID)
+		}
+	}
+}
+
+func newLiveSubscription(matchID, venueID uuid.UUID) *LiveSubscription {
+	ch := make(chan model.LiveUpdate, liveSubscriberBuffer)
+	return &LiveSubscription{C: ch, ch: ch, matchID: matchID, venueID: venueID}
+}
+
+// appendHistory adds an update, keeping at most liveHistorySize entries.
+func appendHistory(history []model.LiveUpdate, update model.LiveUpdate) []model.LiveUpdate {
+	history = append(history, update)
+	if len(history) > liveHistorySize {
+		history = append([]model.LiveUpdate{}, history[len(history)-liveHistorySize:]...)
+	}
+	return history
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+)
+
+func testLiveState(matchID, venueID uuid.UUID) *model.LiveMatchState {
+	return &model.LiveMatchState{MatchID: matchID, VenueID: venueID}
+}
+
+func TestLiveHubDeliversToMatchAndVenue(t *testing.T) {
+	hub := newLiveHub()
+	matchID, venueID := uuid.New(), uuid.New()
+
+	matchSub, _, _ := hub.subscribeMatch(matchID, 0)
+	venueSub, _, _ := hub.subscribeVenue(venueID, 0)
+	otherSub, _, _ := hub.subscribeMatch(uuid.New(), 0)
+
+	hub.publish(model.LiveUpdatePoint, testLiveState(matchID, venueID))
+
+	if update := <-matchSub.C; update.ID != 1 || update.Type != model.LiveUpdatePoint {
+		t.Errorf("unexpected match update: %+v", update)
+	}
+	if update := <-venueSub.C; update.State.MatchID != matchID {
+		t.Errorf("unexpected venue update: %+v", update)
+	}
+	select {
+	case update := <-otherSub.C:
+		t.Errorf("unrelated subscriber received %+v", update)
+	default:
+	}
+}
+
+func TestLiveHubResume(t *testing.T) {
+	hub := newLiveHub()
+	matchID, venueID := uuid.New(), uuid.New()
+
+	for i := 0; i < 3; i++ {
+		hub.publish(model.LiveUpdatePoint, testLiveState(matchID, venueID))
+	}
+
+	// Resume after the first update
+	_, missed, resumed := hub.subscribeMatch(matchID, 1)
+	if !resumed || len(missed) != 2 || missed[0].ID != 2 {
+		t.Errorf("expected to resume with updates 2 and 3, got resumed=%v %+v", resumed, missed)
+	}
+
+	// No Last-Event-ID or an ID from a previous process can't be resumed
+	if _, _, resumed := hub.subscribeMatch(matchID, 0); resumed {
+		t.Error("expected fresh subscription without Last-Event-ID")
+	}
+	if _, _, resumed := hub.subscribeMatch(matchID, 99); resumed {
+		t.Error("expected unknown Last-Event-ID to fall back to snapshot")
+	}
+}
+
+func TestLiveHubResumeGapFallsBackToSnapshot(t *testing.T) {
+	hub := newLiveHub()
+	matchID, venueID := uuid.New(), uuid.New()
+
+	for i := 0; i < liveHistorySize+5; i++ {
+		hub.publish(model.LiveUpdatePoint, testLiveState(matchID, venueID))
+	}
+
+	if _, _, resumed := hub.subscribeMatch(matchID, 2); resumed {
+		t.Error("expected trimmed history to prevent resume")
+	}
+}
+
+func TestLiveHubDropsSlowSubscriber(t *testing.T) {
+	hub := newLiveHub()
+	matchID, venueID := uuid.New(), uuid.New()
+	sub, _, _ := hub.subscribeMatch(matchID, 0)
+
+	for i := 0; i < liveSubscriberBuffer+1; i++ {
+		hub.publish(model.LiveUpdatePoint, testLiveState(matchID, venueID))
+	}
+
+	received := 0
+	for range sub.C {
+		received++
+	}
+	if received != liveSubscriberBuffer {
+		t.Errorf("expected %d buffered updates before close, got %d", liveSubscriberBuffer, received)
+	}
+}
+
+func TestLiveHubForgetsFinishedMatches(t *testing.T) {
+	hub := newLiveHub()
+	matchID, venueID := uuid.New(), uuid.New()
+	sub, _, _ := hub.subscribeMatch(matchID, 0)
+
+	hub.publish(model.LiveUpdatePoint, testLiveState(matchID, venueID))
+	hub.publish(model.LiveUpdateMatchAbandoned, testLiveState(matchID, venueID))
+	if len(hub.matchHistory[matchID]) != 2 {
+		t.Fatal("expected history to be kept while the match is followed")
+	}
+
+	hub.unsubscribe(sub)
+	if _, ok := hub.matchHistory[matchID]; ok {
+		t.Error("expected history to go with the last follower of an abandoned match")
+	}
+}
+
+func TestLiveHubSweepsIdleMatches(t *testing.T) {
+	hub := newLiveHub()
+	idleID, activeID, venueID := uuid.New(), uuid.New(), uuid.New()
+
+	hub.publish(model.LiveUpdatePoint, testLiveState(idleID, venueID))
+	hub.lastPublished[idleID] = time.Now().Add(-liveHistoryIdle - time.Minute)
+	hub.lastSweep = time.Now().Add(-liveHistorySweepInterval)
+
+	hub.publish(model.LiveUpdatePoint, testLiveState(activeID, venueID))
+	if _, ok := hub.matchHistory[idleID]; ok {
+		t.Error("expected the idle match's history to be swept")
+	}
+	if _, ok := hub.matchHistory[activeID]; !ok {
+		t.Error("expected the active match's history to be kept")
+	}
+}
+
+func TestLiveHubResumeWithoutHistory(t *testing.T) {
+	hub := newLiveHub()
+	quietID, endedID, venueID := uuid.New(), uuid.New(), uuid.New()
+
+	hub.publish(model.LiveUpdatePoint, testLiveState(uuid.New(), venueID))
+	cursor := hub.cursor()
+
+	// Nothing happened to the match since the cursor: wait for updates
+	if _, missed, resumed := hub.subscribeMatch(quietID, cursor); !resumed || len(missed) != 0 {
+		t.Errorf("expected to resume a quiet match with nothing missed, got resumed=%v %+v", resumed, missed)
+	}
+
+	// The match finished and its history was dropped: send a snapshot
+	hub.publish(model.LiveUpdateMatchCompleted, testLiveState(endedID, venueID))
+	if _, _, resumed := hub.subscribeMatch(endedID, cursor); resumed {
+		t.Error("expected a dropped history to fall back to snapshot")
+	}
+}
@@ -0,0 +1,464 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/scoring"
+)
+
+// maxImportLine is the longest accepted import line; a long three-set match
+// is a few hundred points.
+const maxImportLine = 64 * 1024
+
+// ImportLine is one match to import: a header plus its points in the
+// S/R/A/D notation used by public point-by-point datasets.
+//
+// Points are written from the server's side: S = server won, R = receiver
+// won, A = ace, D = double fault. Games end with ';', sets with '.', and
+// '/' marks a change of server inside a tie-break (it is ignored; the
+// server is worked out from the rules).
+type ImportLine struct {
+	VenueID   uuid.UUID       `json:"venue_id"`
+	CourtID   *uuid.UUID      `json:"court_id,omitempty"`
+	MatchType model.MatchType `json:"match_type"`
+	Mode      model.MatchMode `json:"mode,omitempty"` // Defaults to standard
+
+	// Players are given by ID or by exact name
+	TeamA []string `json:"team_a"`
+	TeamB []string `json:"team_b"`
+
+	// Servers is the serving order. Standard matches list the rotation
+	// from the first game (singles may give just the first server);
+	// short-format matches list the 2 to 4 servers as when scoring live.
+	Servers []string `json:"servers"`
+	BestOf  int      `json:"best_of,omitempty"` // Short-format games, 3 (default) or 5
+
+	TieBreaks bool       `json:"tie_breaks,omitempty"`
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"` // Defaults to started_at
+	Points    string     `json:"points"`
+}
+
+// ImportMatches reads one match per line (JSON, see ImportLine) and
+// imports them as completed matches. Blank lines and lines starting with
+// '#' are skipped. Nothing is imported if any line is invalid, or when
+// dryRun is set.
+func (s *MatchService) ImportMatches(ctx context.Context, r io.Reader, dryRun bool) (*model.ImportResult, error) {
+	lookup, err := s.newImportLookup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.ImportResult{
+		DryRun:   dryRun,
+		MatchIDs: []uuid.UUID{},
+		Errors:   []model.ImportLineError{},
+	}
+	var matches []repository.ImportedMatch
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), maxImportLine)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		result.Lines++
+
+		im, err := s.parseImportLine(ctx, lookup, []byte(text))
+		if err != nil {
+			result.Errors = append(result.Errors, model.ImportLineError{Line: lineNo, Error: err.Error()})
+			continue
+		}
+		matches = append(matches, im)
+	}
+	if err := scanner.Err(); err != nil {
+		result.Errors = append(result.Errors, model.ImportLineError{Line: lineNo + 1, Error: err.Error()})
+	}
+
+	if len(result.Errors) > 0 || dryRun {
+		return result, nil
+	}
+
+	if err := s.matchRepo.Import(ctx, matches); err != nil {
+		return nil, err
+	}
+	for _, im := range matches {
+		result.MatchIDs = append(result.MatchIDs, im.Match.ID)
+	}
+	result.Imported = len(matches)
+
+	// Imported matches are usually older than the ones already rated
+	s.recomputeRatings(ctx)
+	return result, nil
+}
+
+// importLookup resolves the players, venues and courts named in an import.
+type importLookup struct {
+	players map[uuid.UUID]bool
+	byName  map[string][]uuid.UUID
+	venues  map[uuid.UUID]bool
+	courts  map[uuid.UUID]*model.Court
+}
+
+func (s *MatchService) newImportLookup(ctx context.Context) (*importLookup, error) {
+	players, err := s.playerRepo.List(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	venues, err := s.venueRepo.List(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &importLookup{
+		players: make(map[uuid.UUID]bool, len(players)),
+		byName:  make(map[string][]uuid.UUID, len(players)),
+		venues:  make(map[uuid.UUID]bool, len(venues)),
+		courts:  make(map[uuid.UUID]*model.Court),
+	}
+	for _, p := range players {
+		l.players[p.ID] = true
+		key := strings.ToLower(p.Name)
+		l.byName[key] = append(l.byName[key], p.ID)
+	}
+	for _, v := range venues {
+		l.venues[v.ID] = true
+	}
+	return l, nil
+}
+
+// player resolves a player given by ID or by name (case-insensitive).
+func (l *importLookup) player(ref string) (uuid.UUID, error) {
+	if id, err := uuid.Parse(ref); err == nil {
+		if !l.players[id] {
+			return uuid.Nil, fmt.Errorf("player %s not found", ref)
+		}
+		return id, nil
+	}
+
+	ids := l.byName[strings.ToLower(strings.TrimSpace(ref))]
+	switch len(ids) {
+	case 0:
+		return uuid.Nil, fmt.Errorf("player %q not found", ref)
+	case 1:
+		return ids[0], nil
+	}
+	return uuid.Nil, fmt.Errorf("player name %q is ambiguous; use the player ID", ref)
+}
+
+// playerIDs resolves a list of player references.
+func (l *importLookup) playerIDs(refs []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, len(refs))
+	for i, ref := range refs {
+		id, err := l.player(ref)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// parseImportLine validates a line and builds the match it describes.
+func (s *MatchService) parseImportLine(ctx context.Context, lookup *importLookup, text []byte) (repository.ImportedMatch, error) {
+	var line ImportLine
+	dec := json.NewDecoder(bytes.NewReader(text))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&line); err != nil {
+		return repository.ImportedMatch{}, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	if !lookup.venues[line.VenueID] {
+		return repository.ImportedMatch{}, fmt.Errorf("venue %s not found", line.VenueID)
+	}
+	if line.CourtID != nil {
+		court, ok := lookup.courts[*line.CourtID]
+		if !ok {
+			var err error
+			court, err = s.courtRepo.GetByID(ctx, *line.CourtID)
+			if err != nil {
+				return repository.ImportedMatch{}, fmt.Errorf("court %s not found", line.CourtID)
+			}
+			lookup.courts[*line.CourtID] = court
+		}
+		if court.VenueID != line.VenueID {
+			return repository.ImportedMatch{}, fmt.Errorf("court %s is not at venue %s", line.CourtID, line.VenueID)
+		}
+	}
+
+	teamA, err := lookup.playerIDs(line.TeamA)
+	if err != nil {
+		return repository.ImportedMatch{}, fmt.Errorf("team_a: %w", err)
+	}
+	teamB, err := lookup.playerIDs(line.TeamB)
+	if err != nil {
+		return repository.ImportedMatch{}, fmt.Errorf("team_b: %w", err)
+	}
+	if err := validateTeams(line.MatchType, teamA, teamB); err != nil {
+		return repository.ImportedMatch{}, err
+	}
+
+	servers, err := lookup.playerIDs(line.Servers)
+	if err != nil {
+		return repository.ImportedMatch{}, fmt.Errorf("servers: %w", err)
+	}
+
+	if line.StartedAt.IsZero() {
+		return repository.ImportedMatch{}, fmt.Errorf("started_at is required")
+	}
+	endedAt := line.StartedAt
+	if line.EndedAt != nil {
+		if line.EndedAt.Before(line.StartedAt) {
+			return repository.ImportedMatch{}, fmt.Errorf("ended_at is before started_at")
+		}
+		endedAt = *line.EndedAt
+	}
+
+	mode := line.Mode
+	if mode == "" {
+		mode = model.MatchModeStandard
+	}
+	bestOf, err := matchBestOf(mode, line.BestOf)
+	if err != nil {
+		return repository.ImportedMatch{}, err
+	}
+
+	match := &model.Match{
+		ID:        uuid.New(),
+		VenueID:   line.VenueID,
+		MatchType: line.MatchType,
+		Mode:      mode,
+		BestOf:    bestOf,
+		CourtID:   line.CourtID,
+		Status:    model.MatchStatusCompleted,
+		TieBreaks: line.TieBreaks && mode == model.MatchModeStandard,
+		StartedAt: line.StartedAt,
+		EndedAt:   &endedAt,
+	}
+
+	var players []model.MatchPlayer
+	for _, id := range teamA {
+		players = append(players, model.MatchPlayer{MatchID: match.ID, PlayerID: id, Team: model.TeamA})
+	}
+	for _, id := range teamB {
+		players = append(players, model.MatchPlayer{MatchID: match.ID, PlayerID: id, Team: model.TeamB})
+	}
+
+	var rotation []uuid.UUID
+	if mode == model.MatchModeShort {
+		if err := validateServers(mode, servers, append(teamA, teamB...)); err != nil {
+			return repository.ImportedMatch{}, err
+		}
+		match.Servers = servers
+	} else {
+		rotation, err = servingRotation(players, servers)
+		if err != nil {
+			return repository.ImportedMatch{}, err
+		}
+	}
+
+	events, err := parsePointSequence(match, players, rotation, line.Points)
+	if err != nil {
+		return repository.ImportedMatch{}, err
+	}
+
+	replay, err := newMatchReplay(match, players)
+	if err != nil {
+		return repository.ImportedMatch{}, err
+	}
+	for _, e := range events {
+		replay.apply(e)
+	}
+	match.WinnerTeam, match.Score = matchResult(replay)
+
+	return repository.ImportedMatch{Match: match, Players: players, Events: events}, nil
+}
+
+// servingRotation checks a standard-mode serving order and completes it.
+// Singles may name only the first server. Every rotation alternates teams
+// and includes every player.
+func servingRotation(players []model.MatchPlayer, servers []uuid.UUID) ([]uuid.UUID, error) {
+	team := make(map[uuid.UUID]model.Team, len(players))
+	for _, mp := range players {
+		team[mp.PlayerID] = mp.Team
+	}
+
+	if len(players) == 2 && len(servers) == 1 {
+		for _, mp := range players {
+			if mp.PlayerID != servers[0] {
+				servers = append(servers, mp.PlayerID)
+			}
+		}
+	}
+
+	want := 2
+	if len(players) > 2 {
+		want = 4
+	}
+	if len(servers) != want {
+		return nil, fmt.Errorf("servers must list the %d-game serving rotation", want)
+	}
+
+	served := make(map[uuid.UUID]bool)
+	for i, id := range servers {
+		t, ok := team[id]
+		if !ok {
+			return nil, fmt.Errorf("server %s is not playing in this match", id)
+		}
+		if i > 0 && t == team[servers[i-1]] {
+			return nil, fmt.Errorf("servers must alternate between the teams")
+		}
+		served[id] = true
+	}
+	if len(served) != len(players) {
+		return nil, fmt.Errorf("servers must include every player")
+	}
+	return servers, nil
+}
+
+// parsePointSequence replays a point string through the scoring engine and
+// returns the points as events. The notation's game and set breaks must
+// fall where the engine ends games and sets, and the match must be decided
+// by the last point. Standard matches are served in rotation, one game per
+// server; in a tie-break the first point is served by the next in rotation
+// and then each server serves two points.
+func parsePointSequence(match *model.Match, players []model.MatchPlayer, rotation []uuid.UUID, points string) ([]model.PointEvent, error) {
+	var teams scoring.TeamPlayers
+	teamOf := make(map[uuid.UUID]model.Team, len(players))
+	for _, mp := range players {
+		teamOf[mp.PlayerID] = mp.Team
+		if mp.Team == model.TeamA {
+			teams.TeamA = append(teams.TeamA, mp.PlayerID.String())
+		} else {
+			teams.TeamB = append(teams.TeamB, mp.PlayerID.String())
+		}
+	}
+
+	mode := scoring.ModeStandard
+	var servers []string
+	if match.Mode == model.MatchModeShort {
+		mode = scoring.ModeShortFormat
+		for _, id := range match.Servers {
+			servers = append(servers, id.String())
+		}
+	}
+	state, err := scoring.NewMatchState(mode, teams, servers)
+	if err != nil {
+		return nil, err
+	}
+	state.TieBreaks = match.TieBreaks
+	state.BestOf = match.BestOf
+
+	var events []model.PointEvent
+	games := 0    // games completed so far, for the serving rotation
+	tbPoints := 0 // points played in the current tie-break
+	gameOver := false
+	setOver := false
+
+	for pos, c := range points {
+		at := fmt.Sprintf("character %d", pos+1)
+		switch c {
+		case 'S', 'A', 'R', 'D':
+			if state.Completed {
+				return nil, fmt.Errorf("%s: point after the match was decided", at)
+			}
+			if gameOver {
+				return nil, fmt.Errorf("%s: game ended after point %d (set %d); expected ';' or '.'",
+					at, len(events), state.CurrentSet)
+			}
+
+			var server uuid.UUID
+			if mode == scoring.ModeShortFormat {
+				server, _ = uuid.Parse(scoring.GetCurrentServer(state))
+			} else if scoring.InTieBreak(state) {
+				server = rotation[(games+(tbPoints+1)/2)%len(rotation)]
+			} else {
+				server = rotation[games%len(rotation)]
+			}
+
+			winner := teamOf[server]
+			if c == 'R' || c == 'D' {
+				winner = otherTeam(winner)
+			}
+			serveType := model.ServeTypeUnknown
+			if c == 'D' {
+				serveType = model.ServeTypeDoubleFault
+			}
+
+			tieBreak := scoring.InTieBreak(state)
+			next, err := scoring.ScorePoint(state, scoring.Team(winner))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", at, err)
+			}
+
+			events = append(events, model.PointEvent{
+				ID:              uuid.New(),
+				MatchID:         match.ID,
+				Seq:             len(events) + 1,
+				Timestamp:       match.StartedAt,
+				ServerPlayerID:  server,
+				ServeType:       serveType,
+				PointWinnerTeam: winner,
+			})
+
+			if tieBreak {
+				tbPoints++
+			}
+			if gameEnded(state, next) || next.Completed {
+				games++
+				tbPoints = 0
+				gameOver = true
+				setOver = next.SetsA+next.SetsB != state.SetsA+state.SetsB
+			}
+			state = next
+
+		case ';', '.':
+			if !gameOver {
+				return nil, fmt.Errorf("%s: '%c' but game %d of set %d is not over (points %d-%d)",
+					at, c, state.CurrentGame.GameNumber, state.CurrentSet,
+					state.CurrentGame.PointsA, state.CurrentGame.PointsB)
+			}
+			if c == '.' && !setOver {
+				return nil, fmt.Errorf("%s: '.' but set %d is not over (games %d-%d)",
+					at, state.CurrentSet, state.GamesA, state.GamesB)
+			}
+			gameOver = false
+
+		case '/', ' ', '\t':
+			// Tie-break server changes are derived from the rules
+
+		default:
+			return nil, fmt.Errorf("%s: unexpected %q; expected S, R, A, D, ';', '.' or '/'", at, c)
+		}
+	}
+
+	if len(events) == 0 {
+		return nil, fmt.Errorf("points is empty")
+	}
+	if !state.Completed {
+		return nil, fmt.Errorf("match is not decided after %d points (sets %d-%d, games %d-%d)",
+			len(events), state.SetsA, state.SetsB, state.GamesA, state.GamesB)
+	}
+	return events, nil
+}
+
+// otherTeam returns the opposing team.
+func otherTeam(team model.Team) model.Team {
+	if team == model.TeamA {
+		return model.TeamB
+	}
+	return model.TeamA
+}
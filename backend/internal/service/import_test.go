@@ -0,0 +1,144 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+)
+
+// importRotation returns the singles rotation with team A serving first.
+func importRotation(players []model.MatchPlayer) []uuid.UUID {
+	return []uuid.UUID{players[0].PlayerID, players[1].PlayerID}
+}
+
+func TestParsePointSequence(t *testing.T) {
+	match, players := newTestMatch(model.MatchModeStandard)
+	a, b := players[0].PlayerID, players[1].PlayerID
+
+	// A holds and breaks every game: 6-0 6-0
+	set := strings.Repeat("SSSS;RRRR;", 2) + "SSSS;RRRR."
+	events, err := parsePointSequence(match, players, importRotation(players), "DSSSS;"+set[5:]+set)
+	if err != nil {
+		t.Fatalf("parsePointSequence: %v", err)
+	}
+	if len(events) != 49 {
+		t.Fatalf("expected 49 points, got %d", len(events))
+	}
+
+	// A double fault is a point to the receiver
+	if events[0].ServeType != model.ServeTypeDoubleFault || events[0].PointWinnerTeam != model.TeamB {
+		t.Errorf("expected double fault won by B, got %+v", events[0])
+	}
+	if events[1].ServeType != model.ServeTypeUnknown || events[1].PointWinnerTeam != model.TeamA {
+		t.Errorf("expected unknown serve won by A, got %+v", events[1])
+	}
+	if events[0].ServerPlayerID != a || events[5].ServerPlayerID != b || events[9].ServerPlayerID != a {
+		t.Error("expected servers to alternate by game")
+	}
+	for i, e := range events {
+		if e.Seq != i+1 {
+			t.Fatalf("expected seq %d, got %d", i+1, e.Seq)
+		}
+	}
+}
+
+func TestParsePointSequenceTieBreak(t *testing.T) {
+	match, players := newTestMatch(model.MatchModeStandard)
+	match.TieBreaks = true
+	a, b := players[0].PlayerID, players[1].PlayerID
+
+	// Holds to 6-6, then A takes the tie-break 7-0 and the next set 6-0
+	first := strings.Repeat("SSSS;", 12) + "S/RR/SS/RR."
+	second := strings.Repeat("RRRR;SSSS;", 3)
+	events, err := parsePointSequence(match, players, importRotation(players), first+second[:len(second)-1]+".")
+	if err != nil {
+		t.Fatalf("parsePointSequence: %v", err)
+	}
+
+	// Tie-break serving: one point, then two each
+	tb := events[48:55]
+	want := []uuid.UUID{a, b, b, a, a, b, b}
+	for i, e := range tb {
+		if e.ServerPlayerID != want[i] || e.PointWinnerTeam != model.TeamA {
+			t.Errorf("tie-break point %d: expected %s serving and A winning, got %+v", i+1, want[i], e)
+		}
+	}
+
+	// The receiver of the tie-break's first point serves the next set
+	if events[55].ServerPlayerID != b {
+		t.Error("expected B to serve first after the tie-break")
+	}
+
+	replay, err := newMatchReplay(match, players)
+	if err != nil {
+		t.Fatalf("newMatchReplay: %v", err)
+	}
+	for _, e := range events {
+		replay.apply(e)
+	}
+	if winner, score := matchResult(replay); winner == nil || *winner != model.TeamA || score != "7-6(0) 6-0" {
+		t.Errorf("expected A to win 7-6(0) 6-0, got %v %q", winner, score)
+	}
+}
+
+func TestParsePointSequenceErrors(t *testing.T) {
+	match, players := newTestMatch(model.MatchModeStandard)
+	rotation := importRotation(players)
+	match6 := strings.Repeat("SSSS;RRRR;", 6)
+
+	tests := []struct {
+		name   string
+		points string
+		want   string
+	}{
+		{"game break too early", "SSS;", "is not over"},
+		{"set break mid-set", "SSSS.", "set 1 is not over"},
+		{"missing game break", "SSSSS", "game ended"},
+		{"unknown character", "SSX", "unexpected 'X'"},
+		{"unfinished match", "SSSS;", "not decided"},
+		{"point after the match", match6 + "S", "after the match was decided"},
+		{"empty", " ", "empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parsePointSequence(match, players, rotation, tt.points)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestServingRotation(t *testing.T) {
+	_, players := newTestMatch(model.MatchModeStandard)
+	a, b := players[0].PlayerID, players[1].PlayerID
+
+	// Singles may give only the first server
+	rotation, err := servingRotation(players, []uuid.UUID{b})
+	if err != nil || len(rotation) != 2 || rotation[0] != b || rotation[1] != a {
+		t.Errorf("expected rotation [B A], got %v %v", rotation, err)
+	}
+
+	if _, err := servingRotation(players, []uuid.UUID{a, a}); err == nil {
+		t.Error("expected error for a rotation that doesn't alternate teams")
+	}
+	if _, err := servingRotation(players, []uuid.UUID{uuid.New()}); err == nil {
+		t.Error("expected error for a server not in the match")
+	}
+}
+
+func TestValidateTeams(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+
+	if err := validateTeams(model.MatchTypeDoubles, []uuid.UUID{a, b}, []uuid.UUID{c, uuid.New()}); err != nil {
+		t.Errorf("expected valid doubles teams, got %v", err)
+	}
+	if err := validateTeams(model.MatchTypeDoubles, []uuid.UUID{a, b}, []uuid.UUID{c, a}); err == nil || !strings.Contains(err.Error(), "more than once") {
+		t.Errorf("expected error for a player on both teams, got %v", err)
+	}
+	if err := validateTeams(model.MatchTypeSingles, []uuid.UUID{a}, []uuid.UUID{a}); err == nil {
+		t.Error("expected error for a player playing themselves")
+	}
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+)
+
+// ErrInvalidTransition is returned when a match cannot move to a status
+// from the status it is in.
+var ErrInvalidTransition = errors.New("invalid match status transition")
+
+// ErrAlreadyInStatus is returned when a match is moved to the status it is
+// already in. It is an ErrInvalidTransition.
+var ErrAlreadyInStatus = fmt.Errorf("%w: match is already in that status", ErrInvalidTransition)
+
+// matchTransitions lists the statuses a match may move to from each status.
+// Completed and abandoned matches are final.
+var matchTransitions = map[model.MatchStatus][]model.MatchStatus{
+	model.MatchStatusScheduled:  {model.MatchStatusInProgress, model.MatchStatusAbandoned},
+	model.MatchStatusInProgress: {model.MatchStatusSuspended, model.MatchStatusCompleted, model.MatchStatusAbandoned},
+	model.MatchStatusSuspended:  {model.MatchStatusInProgress, model.MatchStatusCompleted, model.MatchStatusAbandoned},
+}
+
+// acceptsPoints reports whether points can be recorded, voided or corrected
+// in a match with the given status. Points scored before a suspension may
+// still arrive from a device that was offline.
+func acceptsPoints(status model.MatchStatus) bool {
+	return status == model.MatchStatusInProgress || status == model.MatchStatusSuspended
+}
+
+// canTransition reports whether a match may move from one status to another.
+func canTransition(from, to model.MatchStatus) bool {
+	for _, next := range matchTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// StartMatch starts a scheduled match so it can be scored.
+func (s *MatchService) StartMatch(ctx context.Context, matchID uuid.UUID) (*model.Match, error) {
+	return s.transition(ctx, matchID, model.MatchStatusScheduled, model.MatchStatusInProgress, "", model.LiveUpdateMatchStarted)
+}
+
+// SuspendMatch stops play, e.g. for rain or bad light.
+func (s *MatchService) SuspendMatch(ctx context.Context, matchID uuid.UUID, reason string) (*model.Match, error) {
+	return s.transition(ctx, matchID, "", model.MatchStatusSuspended, reason, model.LiveUpdateMatchSuspended)
+}
+
+// ResumeMatch restarts play on a suspended match.
+func (s *MatchService) ResumeMatch(ctx context.Context, matchID uuid.UUID) (*model.Match, error) {
+	return s.transition(ctx, matchID, model.MatchStatusSuspended, model.MatchStatusInProgress, "", model.LiveUpdateMatchResumed)
+}
+
+// AbandonMatch ends a match without a result. A tournament match it was
+// played as can then be started again.
+func (s *MatchService) AbandonMatch(ctx context.Context, matchID uuid.UUID) (*model.Match, error) {
+	return s.transition(ctx, matchID, "", model.MatchStatusAbandoned, "", model.LiveUpdateMatchAbandoned)
+}
+
+// VoidMatch takes back the result of a completed match, such as one scored
+// by mistake, leaving it abandoned with its points but no winner. A
+// tournament result it decided is taken back in the same transaction; if
+// later tournament matches depend on it, it fails with
+// tournament.ErrResultLocked and nothing changes.
+func (s *MatchService) VoidMatch(ctx context.Context, matchID uuid.UUID) (*model.Match, error) {
+	match, err := s.matchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("match not found: %w", err)
+	}
+	if match.Status != model.MatchStatusCompleted {
+		return nil, fmt.Errorf("%w: only completed matches can be voided, match is %s", ErrInvalidTransition, match.Status)
+	}
+
+	rated, err := s.ratings.IsRated(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The match keeps the time it ended
+	endedAt := time.Now()
+	if match.EndedAt != nil {
+		endedAt = *match.EndedAt
+	}
+	change := repository.StatusChange{
+		MatchID: matchID,
+		From:    model.MatchStatusCompleted,
+		To:      model.MatchStatusAbandoned,
+		At:      endedAt,
+		Result:  &repository.MatchResult{Score: match.Score},
+	}
+	if err := s.changeScoredStatus(ctx, change, releaseStep(matchID)); err != nil {
+		return nil, err
+	}
+	match.Status = model.MatchStatusAbandoned
+	match.EndedAt = &endedAt
+	match.WinnerTeam = nil
+
+	if rated {
+		s.recomputeRatings(ctx)
+	}
+
+	s.live.setStatus(matchID, model.MatchStatusAbandoned, &endedAt)
+	s.publishLiveState(ctx, matchID, model.LiveUpdateMatchAbandoned, nil)
+	return match, nil
+}
+
+// transition moves a match to a new status and notifies live subscribers.
+// If from is set the match must currently be in that status. Completing a
+// match stores its result; completing or abandoning one also updates the
+// tournament match it was played as.
+func (s *MatchService) transition(ctx context.Context, matchID uuid.UUID, from, to model.MatchStatus, reason string, updateType model.LiveUpdateType) (*model.Match, error) {
+	match, err := s.matchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("match not found: %w", err)
+	}
+
+	if match.Status == to {
+		return nil, fmt.Errorf("%w (%s)", ErrAlreadyInStatus, to)
+	}
+	if (from != "" && match.Status != from) || !canTransition(match.Status, to) {
+		return nil, fmt.Errorf("%w: match is %s", ErrInvalidTransition, match.Status)
+	}
+
+	now := time.Now()
+	change := repository.StatusChange{MatchID: matchID, From: match.Status, To: to, At: now, Reason: reason}
+	switch to {
+	case model.MatchStatusCompleted:
+		err = s.completeScoredMatch(ctx, match, change)
+	case model.MatchStatusAbandoned:
+		err = s.changeScoredStatus(ctx, change, releaseStep(matchID))
+	default:
+		err = s.matchRepo.ChangeStatus(ctx, change)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var endedAt *time.Time
+	if to == model.MatchStatusCompleted || to == model.MatchStatusAbandoned {
+		endedAt = &now
+		match.EndedAt = endedAt
+	}
+	if match.Status == model.MatchStatusScheduled && to == model.MatchStatusInProgress {
+		match.StartedAt = now
+	}
+	match.Status = to
+
+	s.live.setStatus(matchID, to, endedAt)
+	s.publishLiveState(ctx, matchID, updateType, nil)
+	return match, nil
+}
+
+// playingDuration returns how long a match has been played, excluding
+// suspensions. Matches still in play are measured up to now.
+func playingDuration(match *model.Match, suspensions []model.MatchSuspension, now time.Time) time.Duration {
+	if match.Status == model.MatchStatusScheduled {
+		return 0
+	}
+
+	end := now
+	if match.EndedAt != nil {
+		end = *match.EndedAt
+	}
+
+	played := end.Sub(match.StartedAt)
+	for _, sp := range suspensions {
+		resumed := end
+		if sp.ResumedAt != nil && sp.ResumedAt.Before(end) {
+			resumed = *sp.ResumedAt
+		}
+		if resumed.After(sp.SuspendedAt) {
+			played -= resumed.Sub(sp.SuspendedAt)
+		}
+	}
+
+	if played < 0 {
+		return 0
+	}
+	return played
+}
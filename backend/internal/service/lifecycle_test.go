@@ -0,0 +1,66 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+)
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		from, to model.MatchStatus
+		want     bool
+	}{
+		{model.MatchStatusInProgress, model.MatchStatusSuspended, true},
+		{model.MatchStatusSuspended, model.MatchStatusInProgress, true},
+		{model.MatchStatusSuspended, model.MatchStatusAbandoned, true},
+		{model.MatchStatusScheduled, model.MatchStatusInProgress, true},
+		{model.MatchStatusScheduled, model.MatchStatusSuspended, false},
+		{model.MatchStatusSuspended, model.MatchStatusSuspended, false},
+		{model.MatchStatusCompleted, model.MatchStatusInProgress, false},
+		{model.MatchStatusAbandoned, model.MatchStatusCompleted, false},
+	}
+
+	for _, tt := range tests {
+		if got := canTransition(tt.from, tt.to); got != tt.want {
+			t.Errorf("canTransition(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestAcceptsPoints(t *testing.T) {
+	for status, want := range map[model.MatchStatus]bool{
+		model.MatchStatusScheduled:  false,
+		model.MatchStatusInProgress: true,
+		model.MatchStatusSuspended:  true,
+		model.MatchStatusCompleted:  false,
+		model.MatchStatusAbandoned:  false,
+	} {
+		if got := acceptsPoints(status); got != want {
+			t.Errorf("acceptsPoints(%s) = %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestPlayingDurationExcludesSuspensions(t *testing.T) {
+	start := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	end := start.Add(3 * time.Hour)
+	resumed := start.Add(90 * time.Minute)
+
+	match := &model.Match{Status: model.MatchStatusCompleted, StartedAt: start, EndedAt: &end}
+	suspensions := []model.MatchSuspension{
+		{SuspendedAt: start.Add(30 * time.Minute), ResumedAt: &resumed},
+	}
+
+	if got := playingDuration(match, suspensions, end); got != 2*time.Hour {
+		t.Errorf("expected 2h of play, got %v", got)
+	}
+
+	// A match still suspended stops accruing time at the suspension
+	match = &model.Match{Status: model.MatchStatusSuspended, StartedAt: start}
+	open := []model.MatchSuspension{{SuspendedAt: start.Add(45 * time.Minute)}}
+	if got := playingDuration(match, open, start.Add(24*time.Hour)); got != 45*time.Minute {
+		t.Errorf("expected 45m of play, got %v", got)
+	}
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+)
+
+// maxLiveEntries bounds the number of matches kept in the live state cache.
+const maxLiveEntries = 500
+
+// liveStateCache keeps a replayed scoring state per match so live score
+// requests don't re-read every point event. Entries are updated
+// incrementally as events arrive and rebuilt from the database whenever an
+// update can't be applied in order.
+type liveStateCache struct {
+	mu      sync.Mutex
+	entries map[uuid.UUID]*liveEntry
+
+	// generations is set from nextGen on every change to a match so a
+	// rebuild that raced with a write is discarded instead of caching stale
+	// state. Matches without one are at forgottenAt, the generation when a
+	// match was last dropped.
+	generations map[uuid.UUID]uint64
+	nextGen     uint64
+	forgottenAt uint64
+}
+
+type liveEntry struct {
+	replay   *matchReplay
+	lastUsed time.Time
+}
+
+func newLiveStateCache() *liveStateCache {
+	return &liveStateCache{
+		entries:     make(map[uuid.UUID]*liveEntry),
+		generations: make(map[uuid.UUID]uint64),
+	}
+}
+
+// get returns the cached state for a match.
+func (c *liveStateCache) get(matchID uuid.UUID) (*model.LiveMatchState, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[matchID]
+	if !ok {
+		return nil, false
+	}
+	entry.lastUsed = time.Now()
+	return entry.replay.snapshot(), true
+}
+
+// generation returns the current generation of a match.
+func (c *liveStateCache) generation(matchID uuid.UUID) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.currentGeneration(matchID)
+}
+
+// currentGeneration returns the generation of a match. Caller holds c.mu.
+func (c *liveStateCache) currentGeneration(matchID uuid.UUID) uint64 {
+	if gen, ok := c.generations[matchID]; ok {
+		return gen
+	}
+	return c.forgottenAt
+}
+
+// bump records a change to a match. Caller holds c.mu.
+func (c *liveStateCache) bump(matchID uuid.UUID) {
+	c.nextGen++
+	c.generations[matchID] = c.nextGen
+}
+
+// store caches a freshly built replay unless the match changed since gen.
+func (c *liveStateCache) store(matchID uuid.UUID, gen uint64, replay *matchReplay) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.currentGeneration(matchID) != gen {
+		return
+	}
+	if len(c.entries) >= maxLiveEntries {
+		c.evictOldest()
+	}
+	c.entries[matchID] = &liveEntry{replay: replay, lastUsed: time.Now()}
+}
+
+// applyEvents adds newly stored events to a cached match.
+// Events that fill an earlier gap in the sequence invalidate the entry so
+// the next read replays the match from the database.
+func (c *liveStateCache) applyEvents(matchID uuid.UUID, events []model.PointEvent) (*model.LiveMatchState, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.bump(matchID)
+
+	entry, ok := c.entries[matchID]
+	if !ok {
+		return nil, false
+	}
+
+	pending := make([]model.PointEvent, 0, len(events))
+	for _, e := range events {
+		if !entry.replay.applied[e.ID] {
+			pending = append(pending, e)
+		}
+	}
+	sort.SliceStable(pending, func(i, j int) bool {
+		return pending[i].Seq < pending[j].Seq
+	})
+
+	if len(pending) > 0 && pending[0].Seq <= entry.replay.lastSeq {
+		delete(c.entries, matchID)
+		return nil, false
+	}
+
+	for _, e := range pending {
+		entry.replay.apply(e)
+	}
+	entry.lastUsed = time.Now()
+	return entry.replay.snapshot(), true
+}
+
+// setStatus records a status change on a cached match. endedAt is nil
+// unless the match has ended.
+func (c *liveStateCache) setStatus(matchID uuid.UUID, status model.MatchStatus, endedAt *time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.bump(matchID)
+	if entry, ok := c.entries[matchID]; ok {
+		match := *entry.replay.match
+		match.Status = status
+		if endedAt != nil {
+			match.EndedAt = endedAt
+		}
+		entry.replay.match = &match
+	}
+}
+
+// invalidate drops a match from the cache.
+func (c *liveStateCache) invalidate(matchID uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.bump(matchID)
+	delete(c.entries, matchID)
+}
+
+// evictOldest removes the least recently used entry. Caller holds c.mu.
+func (c *liveStateCache) evictOldest() {
+	var oldestID uuid.UUID
+	var oldest time.Time
+	for id, entry := range c.entries {
+		if oldest.IsZero() || entry.lastUsed.Before(oldest) {
+			oldestID = id
+			oldest = entry.lastUsed
+		}
+	}
+	c.forgetMatch(oldestID)
+}
+
+// forget drops a match from the cache along with its generation, e.g.
+// once it has finished or gone idle.
+func (c *liveStateCache) forget(matchID uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.forgetMatch(matchID)
+}
+
+// forgetMatch drops a match and its generation. Caller holds c.mu.
+func (c *liveStateCache) forgetMatch(matchID uuid.UUID) {
+	delete(c.entries, matchID)
+	delete(c.generations, matchID)
+	c.forgottenAt = c.nextGen
+}
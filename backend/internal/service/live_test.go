@@ -0,0 +1,171 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+)
+
+// newTestMatch returns a singles match and its players.
+func newTestMatch(mode model.MatchMode) (*model.Match, []model.MatchPlayer) {
+	a := uuid.New()
+	b := uuid.New()
+	match := &model.Match{
+		ID:        uuid.New(),
+		VenueID:   uuid.New(),
+		MatchType: model.MatchTypeSingles,
+		Mode:      mode,
+	}
+	if mode == model.MatchModeShort {
+		match.Servers = []uuid.UUID{a, b, a}
+	}
+	players := []model.MatchPlayer{
+		{MatchID: match.ID, PlayerID: a, Team: model.TeamA},
+		{MatchID: match.ID, PlayerID: b, Team: model.TeamB},
+	}
+	return match, players
+}
+
+// pointEvents builds events from a sequence like "AAAB", numbered from 1
+// and one second apart.
+func pointEvents(matchID, server uuid.UUID, start time.Time, sequence string) []model.PointEvent {
+	events := make([]model.PointEvent, 0, len(sequence))
+	for i, c := range sequence {
+		events = append(events, model.PointEvent{
+			ID:              uuid.New(),
+			MatchID:         matchID,
+			Seq:             i + 1,
+			Timestamp:       start.Add(time.Duration(i) * time.Second),
+			ServerPlayerID:  server,
+			ServeType:       model.ServeTypeFirst,
+			PointWinnerTeam: model.Team(string(c)),
+		})
+	}
+	return events
+}
+
+func TestMatchReplaySetHistory(t *testing.T) {
+	match, players := newTestMatch(model.MatchModeStandard)
+	replay, err := newMatchReplay(match, players)
+	if err != nil {
+		t.Fatalf("newMatchReplay: %v", err)
+	}
+
+	// 6-0 to A, then one point into the second set
+	seq := ""
+	for i := 0; i < 6; i++ {
+		seq += "AAAA"
+	}
+	seq += "B"
+	for _, e := range pointEvents(match.ID, players[0].PlayerID, time.Now(), seq) {
+		replay.apply(e)
+	}
+
+	state := replay.snapshot()
+	if len(state.Sets) != 1 || state.Sets[0] != (model.SetScore{GamesA: 6, GamesB: 0}) {
+		t.Errorf("expected one 6-0 set, got %+v", state.Sets)
+	}
+	if state.Display.Points.B != "15" {
+		t.Errorf("expected 0-15 in the current game, got %+v", state.Display.Points)
+	}
+	if state.ServerPlayerID == nil || *state.ServerPlayerID != players[0].PlayerID {
+		t.Error("expected server of the game in progress")
+	}
+	if state.PointsPlayed != 25 {
+		t.Errorf("expected 25 points played, got %d", state.PointsPlayed)
+	}
+}
+
+func TestMatchReplayShortFormatServer(t *testing.T) {
+	match, players := newTestMatch(model.MatchModeShort)
+	replay, err := newMatchReplay(match, players)
+	if err != nil {
+		t.Fatalf("newMatchReplay: %v", err)
+	}
+
+	for _, e := range pointEvents(match.ID, players[0].PlayerID, time.Now(), "AAAA") {
+		replay.apply(e)
+	}
+
+	state := replay.snapshot()
+	if state.ServerPlayerID == nil || *state.ServerPlayerID != match.Servers[1] {
+		t.Error("expected second server for game 2")
+	}
+	if state.Display.Games.A != 1 {
+		t.Errorf("expected 1 game to A, got %d", state.Display.Games.A)
+	}
+}
+
+func TestLiveStateCacheIncrementalAndOutOfOrder(t *testing.T) {
+	match, players := newTestMatch(model.MatchModeStandard)
+	replay, _ := newMatchReplay(match, players)
+
+	cache := newLiveStateCache()
+	start := time.Now()
+	events := pointEvents(match.ID, players[0].PlayerID, start, "AAB")
+
+	replay.apply(events[0])
+	cache.store(match.ID, cache.generation(match.ID), replay)
+
+	// In-order events are applied incrementally; duplicates are ignored
+	state, ok := cache.applyEvents(match.ID, events)
+	if !ok {
+		t.Fatal("expected incremental update")
+	}
+	if state.PointsPlayed != 3 {
+		t.Errorf("expected 3 points played, got %d", state.PointsPlayed)
+	}
+
+	// An event behind the last applied sequence forces a rebuild, even when
+	// its clock says it came later
+	late := pointEvents(match.ID, players[0].PlayerID, start.Add(time.Minute), "A")
+	if _, ok := cache.applyEvents(match.ID, late); ok {
+		t.Error("expected out-of-order event to invalidate the entry")
+	}
+	if _, ok := cache.get(match.ID); ok {
+		t.Error("expected entry to be dropped")
+	}
+}
+
+func TestLiveStateCacheDiscardsStaleRebuild(t *testing.T) {
+	match, players := newTestMatch(model.MatchModeStandard)
+	replay, _ := newMatchReplay(match, players)
+
+	cache := newLiveStateCache()
+	gen := cache.generation(match.ID)
+
+	// A write lands while the rebuild is reading from the database
+	cache.applyEvents(match.ID, pointEvents(match.ID, players[0].PlayerID, time.Now(), "A"))
+	cache.store(match.ID, gen, replay)
+
+	if _, ok := cache.get(match.ID); ok {
+		t.Error("expected stale rebuild to be discarded")
+	}
+}
+
+func TestLiveStateCacheForget(t *testing.T) {
+	match, players := newTestMatch(model.MatchModeStandard)
+	replay, _ := newMatchReplay(match, players)
+
+	cache := newLiveStateCache()
+	gen := cache.generation(match.ID)
+	cache.applyEvents(match.ID, pointEvents(match.ID, players[0].PlayerID, time.Now(), "A"))
+
+	cache.forget(match.ID)
+	if len(cache.generations) != 0 {
+		t.Errorf("expected the generation to be dropped, got %v", cache.generations)
+	}
+
+	// A rebuild that started before the match was forgotten is still stale
+	cache.store(match.ID, gen, replay)
+	if _, ok := cache.get(match.ID); ok {
+		t.Error("expected stale rebuild to be discarded")
+	}
+
+	cache.store(match.ID, cache.generation(match.ID), replay)
+	if _, ok := cache.get(match.ID); !ok {
+		t.Error("expected a fresh rebuild to be cached")
+	}
+}
@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,11 +12,37 @@ import (
 	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
 )
 
+// ErrInvalidMatch is matched by errors for a match that fails validation,
+// as opposed to a failure looking up or storing it.
+var ErrInvalidMatch = errors.New("invalid match")
+
+// invalidMatchError marks a validation error as ErrInvalidMatch while
+// keeping its message.
+type invalidMatchError struct{ err error }
+
+func (e invalidMatchError) Error() string   { return e.err.Error() }
+func (e invalidMatchError) Unwrap() []error { return []error{e.err, ErrInvalidMatch} }
+
+// invalidMatch marks a validation error as ErrInvalidMatch. Lookups that
+// failed for a reason other than a missing venue, court or player are left
+// unmarked.
+func invalidMatch(err error) error {
+	if errors.Unwrap(err) != nil && !errors.Is(err, repository.ErrNotFound) {
+		return err
+	}
+	return invalidMatchError{err}
+}
+
 // MatchService handles match business logic.
 type MatchService struct {
-	matchRepo  *repository.MatchRepository
-	playerRepo *repository.PlayerRepository
-	venueRepo  *repository.VenueRepository
+	matchRepo      *repository.MatchRepository
+	playerRepo     *repository.PlayerRepository
+	venueRepo      *repository.VenueRepository
+	courtRepo      *repository.CourtRepository
+	ratings        *RatingService
+	tournamentRepo *repository.TournamentRepository
+	live           *liveStateCache
+	hub            *liveHub
 }
 
 // NewMatchService creates a new match service.
@@ -22,11 +50,24 @@ func NewMatchService(
 	matchRepo *repository.MatchRepository,
 	playerRepo *repository.PlayerRepository,
 	venueRepo *repository.VenueRepository,
+	courtRepo *repository.CourtRepository,
+	ratings *RatingService,
+	tournamentRepo *repository.TournamentRepository,
 ) *MatchService {
+	live := newLiveStateCache()
+	hub := newLiveHub()
+	// Cached states and their generations go with the match's history
+	hub.onForget = live.forget
+
 	return &MatchService{
-		matchRepo:  matchRepo,
-		playerRepo: playerRepo,
-		venueRepo:  venueRepo,
+		matchRepo:      matchRepo,
+		playerRepo:     playerRepo,
+		venueRepo:      venueRepo,
+		courtRepo:      courtRepo,
+		ratings:        ratings,
+		tournamentRepo: tournamentRepo,
+		live:           live,
+		hub:            hub,
 	}
 }
 
@@ -34,42 +75,37 @@ func NewMatchService(
 type CreateMatchRequest struct {
 	VenueID   uuid.UUID       `json:"venue_id"`
 	MatchType model.MatchType `json:"match_type"`
+	Mode      model.MatchMode `json:"mode,omitempty"`    // Defaults to standard
+	Servers   []uuid.UUID     `json:"servers,omitempty"` // Short-format serving order
+	BestOf    int             `json:"best_of,omitempty"` // Short-format games, 3 (default) or 5
 	TeamA     []uuid.UUID     `json:"team_a"`
 	TeamB     []uuid.UUID     `json:"team_b"`
+
+	// CourtID optionally places the match on a court at the venue
+	CourtID *uuid.UUID `json:"court_id,omitempty"`
+
+	// ScheduledAt creates a scheduled match to be started later
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
 }
 
-// CreateMatch creates a new match and returns its ID.
+// CreateMatch creates a new match and returns its ID. Validation errors
+// match ErrInvalidMatch.
 func (s *MatchService) CreateMatch(ctx context.Context, req CreateMatchRequest) (*model.Match, error) {
-	// Validate venue exists
-	_, err := s.venueRepo.GetByID(ctx, req.VenueID)
-	if err != nil {
-		return nil, fmt.Errorf("invalid venue: %w", err)
+	if err := s.validateMatchSetup(ctx, req.VenueID, req.CourtID, req.MatchType, req.TeamA, req.TeamB); err != nil {
+		return nil, invalidMatch(err)
 	}
 
-	// Validate match type and player count
-	if req.MatchType == model.MatchTypeSingles {
-		if len(req.TeamA) != 1 || len(req.TeamB) != 1 {
-			return nil, fmt.Errorf("singles match requires exactly 1 player per team")
-		}
-	} else if req.MatchType == model.MatchTypeDoubles {
-		if len(req.TeamA) != 2 || len(req.TeamB) != 2 {
-			return nil, fmt.Errorf("doubles match requires exactly 2 players per team")
-		}
-	} else if req.MatchType == model.MatchTypeAustralianDoubles {
-		// 1v2 format: Team A has 1 player, Team B has 2 players
-		if len(req.TeamA) != 1 || len(req.TeamB) != 2 {
-			return nil, fmt.Errorf("1v2 match requires exactly 1 player on team A and 2 players on team B")
-		}
-	} else {
-		return nil, fmt.Errorf("invalid match type")
+	// Validate scoring mode and serving order
+	mode := req.Mode
+	if mode == "" {
+		mode = model.MatchModeStandard
 	}
-
-	// Validate all players exist
-	allPlayers := append(req.TeamA, req.TeamB...)
-	for _, playerID := range allPlayers {
-		if _, err := s.playerRepo.GetByID(ctx, playerID); err != nil {
-			return nil, fmt.Errorf("invalid player %s: %w", playerID, err)
-		}
+	if err := validateServers(mode, req.Servers, append(req.TeamA, req.TeamB...)); err != nil {
+		return nil, invalidMatch(err)
+	}
+	bestOf, err := matchBestOf(mode, req.BestOf)
+	if err != nil {
+		return nil, invalidMatch(err)
 	}
 
 	// Create match
@@ -77,8 +113,18 @@ func (s *MatchService) CreateMatch(ctx context.Context, req CreateMatchRequest)
 		ID:        uuid.New(),
 		VenueID:   req.VenueID,
 		MatchType: req.MatchType,
+		Mode:      mode,
+		Servers:   req.Servers,
+		BestOf:    bestOf,
+		CourtID:   req.CourtID,
+		Status:    model.MatchStatusInProgress,
 		StartedAt: time.Now(),
 	}
+	if req.ScheduledAt != nil {
+		match.Status = model.MatchStatusScheduled
+		match.ScheduledAt = req.ScheduledAt
+		match.StartedAt = *req.ScheduledAt
+	}
 
 	// Prepare match players
 	var matchPlayers []model.MatchPlayer
@@ -104,15 +150,123 @@ func (s *MatchService) CreateMatch(ctx context.Context, req CreateMatchRequest)
 	return match, nil
 }
 
+// validateMatchSetup checks the venue, court and players of a new match.
+func (s *MatchService) validateMatchSetup(ctx context.Context, venueID uuid.UUID, courtID *uuid.UUID, matchType model.MatchType, teamA, teamB []uuid.UUID) error {
+	// Validate venue exists
+	if _, err := s.venueRepo.GetByID(ctx, venueID); err != nil {
+		return fmt.Errorf("invalid venue: %w", err)
+	}
+
+	// Validate match type and player count
+	if err := validateTeams(matchType, teamA, teamB); err != nil {
+		return err
+	}
+
+	// Validate all players exist
+	for _, playerID := range append(append([]uuid.UUID{}, teamA...), teamB...) {
+		if _, err := s.playerRepo.GetByID(ctx, playerID); err != nil {
+			return fmt.Errorf("invalid player %s: %w", playerID, err)
+		}
+	}
+
+	// Validate court belongs to the venue
+	if courtID != nil {
+		court, err := s.courtRepo.GetByID(ctx, *courtID)
+		if err != nil {
+			return fmt.Errorf("invalid court: %w", err)
+		}
+		if court.VenueID != venueID || !court.Active {
+			return fmt.Errorf("court is not an active court at this venue")
+		}
+	}
+	return nil
+}
+
+// validateTeams checks the number of players on each team for a match type,
+// and that no player is listed twice.
+func validateTeams(matchType model.MatchType, teamA, teamB []uuid.UUID) error {
+	if matchType == model.MatchTypeSingles {
+		if len(teamA) != 1 || len(teamB) != 1 {
+			return fmt.Errorf("singles match requires exactly 1 player per team")
+		}
+	} else if matchType == model.MatchTypeDoubles {
+		if len(teamA) != 2 || len(teamB) != 2 {
+			return fmt.Errorf("doubles match requires exactly 2 players per team")
+		}
+	} else if matchType == model.MatchTypeAustralianDoubles {
+		// 1v2 format: Team A has 1 player, Team B has 2 players
+		if len(teamA) != 1 || len(teamB) != 2 {
+			return fmt.Errorf("1v2 match requires exactly 1 player on team A and 2 players on team B")
+		}
+	} else {
+		return fmt.Errorf("invalid match type")
+	}
+
+	seen := make(map[uuid.UUID]bool, len(teamA)+len(teamB))
+	for _, id := range append(append([]uuid.UUID{}, teamA...), teamB...) {
+		if seen[id] {
+			return fmt.Errorf("player %s is listed more than once", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// validateServers checks the serving order against the scoring mode.
+// Short-format matches need 2 to 4 servers, all playing in the match.
+func validateServers(mode model.MatchMode, servers, players []uuid.UUID) error {
+	switch mode {
+	case model.MatchModeStandard:
+		if len(servers) != 0 {
+			return fmt.Errorf("standard mode must not specify servers")
+		}
+	case model.MatchModeShort:
+		if len(servers) < 2 || len(servers) > 4 {
+			return fmt.Errorf("short-format match requires 2 to 4 servers")
+		}
+		inMatch := make(map[uuid.UUID]bool, len(players))
+		for _, id := range players {
+			inMatch[id] = true
+		}
+		for _, id := range servers {
+			if !inMatch[id] {
+				return fmt.Errorf("server %s is not playing in this match", id)
+			}
+		}
+	default:
+		return fmt.Errorf("invalid match mode")
+	}
+	return nil
+}
+
+// matchBestOf checks the number of games in a short-format match, which
+// defaults to 3. Standard matches don't set it.
+func matchBestOf(mode model.MatchMode, bestOf int) (int, error) {
+	if mode != model.MatchModeShort {
+		if bestOf != 0 {
+			return 0, fmt.Errorf("best_of is only used by short-format matches")
+		}
+		return 0, nil
+	}
+	switch bestOf {
+	case 0:
+		return 3, nil
+	case 3, 5:
+		return bestOf, nil
+	}
+	return 0, fmt.Errorf("short-format match must be best of 3 or 5 games")
+}
+
 // AddEvents adds point events to a match (idempotent).
-func (s *MatchService) AddEvents(ctx context.Context, matchID uuid.UUID, events []model.PointEvent) (int, error) {
-	// Verify match exists and is not completed
+// The result lists any sequence numbers the server has not yet received.
+func (s *MatchService) AddEvents(ctx context.Context, matchID uuid.UUID, events []model.PointEvent) (*model.AddEventsResult, error) {
+	// Verify match exists and is being played
 	match, err := s.matchRepo.GetByID(ctx, matchID)
 	if err != nil {
-		return 0, fmt.Errorf("match not found: %w", err)
+		return nil, fmt.Errorf("match not found: %w", err)
 	}
-	if match.EndedAt != nil {
-		return 0, fmt.Errorf("cannot add events to completed match")
+	if !acceptsPoints(match.Status) {
+		return nil, fmt.Errorf("cannot add events to %s match", match.Status)
 	}
 
 	// Set match ID for all events
@@ -120,12 +274,206 @@ func (s *MatchService) AddEvents(ctx context.Context, matchID uuid.UUID, events
 		events[i].MatchID = matchID
 	}
 
-	return s.matchRepo.InsertEvents(ctx, events)
+	var pending []model.PointEvent
+	stored, voided, inserted, err := s.matchRepo.AddEvents(ctx, matchID, func(stored, voided []model.PointEvent) ([]model.PointEvent, error) {
+		// Voided points keep their IDs and sequence numbers
+		taken := append(append([]model.PointEvent{}, stored...), voided...)
+		pending, err = sequenceEvents(taken, events)
+		return pending, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	seqs := make([]int, 0, len(stored)+len(voided)+len(pending))
+	for _, e := range stored {
+		seqs = append(seqs, e.Seq)
+	}
+	for _, e := range voided {
+		seqs = append(seqs, e.Seq)
+	}
+	for _, e := range pending {
+		seqs = append(seqs, e.Seq)
+	}
+	lastSeq, missing := missingSequences(seqs)
+
+	if inserted > 0 {
+		s.publishLiveState(ctx, matchID, model.LiveUpdatePoint, pending)
+	}
+	return &model.AddEventsResult{
+		Inserted: inserted,
+		Total:    len(events),
+		LastSeq:  lastSeq,
+		Missing:  missing,
+	}, nil
+}
+
+// VoidEvent removes a recorded point from a match that is in progress or
+// suspended.
+func (s *MatchService) VoidEvent(ctx context.Context, matchID, eventID uuid.UUID) error {
+	match, err := s.matchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		return fmt.Errorf("match not found: %w", err)
+	}
+	if !acceptsPoints(match.Status) {
+		return fmt.Errorf("cannot void points of %s match", match.Status)
+	}
+
+	if err := s.matchRepo.DeleteEvent(ctx, matchID, eventID); err != nil {
+		return fmt.Errorf("event not found: %w", err)
+	}
+
+	s.live.invalidate(matchID)
+	s.publishLiveState(ctx, matchID, model.LiveUpdatePointVoided, nil)
+	return nil
+}
+
+// CorrectEvent replaces the outcome of a recorded point in a match that is
+// in progress or suspended, keeping its position in the point order.
+func (s *MatchService) CorrectEvent(ctx context.Context, event model.PointEvent) error {
+	match, err := s.matchRepo.GetByID(ctx, event.MatchID)
+	if err != nil {
+		return fmt.Errorf("match not found: %w", err)
+	}
+	if !acceptsPoints(match.Status) {
+		return fmt.Errorf("cannot correct points of %s match", match.Status)
+	}
+
+	if err := s.matchRepo.UpdateEvent(ctx, event); err != nil {
+		return fmt.Errorf("event not found: %w", err)
+	}
+
+	s.live.invalidate(event.MatchID)
+	s.publishLiveState(ctx, event.MatchID, model.LiveUpdatePointCorrected, nil)
+	return nil
 }
 
-// CompleteMatch marks a match as completed.
+// CompleteMatch marks a match as completed and stores its result, also in
+// the tournament match it was played as. A tournament match can't be
+// completed without a winner.
 func (s *MatchService) CompleteMatch(ctx context.Context, matchID uuid.UUID) error {
-	return s.matchRepo.Complete(ctx, matchID, time.Now())
+	match, err := s.transition(ctx, matchID, "", model.MatchStatusCompleted, "", model.LiveUpdateMatchCompleted)
+	if err != nil {
+		return err
+	}
+
+	s.rateMatch(ctx, match, nil)
+	return nil
+}
+
+// publishLiveState updates the live cache with new events and pushes the
+// resulting state to subscribers. Failures only affect live followers, so
+// they are logged rather than returned.
+func (s *MatchService) publishLiveState(ctx context.Context, matchID uuid.UUID, updateType model.LiveUpdateType, events []model.PointEvent) {
+	state, ok := s.live.applyEvents(matchID, events)
+	if !ok {
+		var err error
+		state, err = s.GetLiveState(ctx, matchID)
+		if err != nil {
+			log.Printf("live: failed to refresh match %s: %v", matchID, err)
+			return
+		}
+	}
+	s.hub.publish(updateType, state)
+}
+
+// SubscribeMatch follows live updates for a match.
+// The returned updates should be sent before reading from the subscription:
+// either the updates missed since lastEventID, or a snapshot of the current
+// state when the stream can't be resumed.
+func (s *MatchService) SubscribeMatch(ctx context.Context, matchID uuid.UUID, lastEventID uint64) (*LiveSubscription, []model.LiveUpdate, error) {
+	sub, missed, resumed := s.hub.subscribeMatch(matchID, lastEventID)
+	if resumed {
+		return sub, missed, nil
+	}
+
+	state, err := s.GetLiveState(ctx, matchID)
+	if err != nil {
+		s.hub.unsubscribe(sub)
+		return nil, nil, err
+	}
+	return sub, []model.LiveUpdate{{Type: model.LiveUpdateSnapshot, State: state}}, nil
+}
+
+// SubscribeVenue follows live updates for every match at a venue.
+// Without a resumable lastEventID, a snapshot of each in-progress match is
+// returned first.
+func (s *MatchService) SubscribeVenue(ctx context.Context, venueID uuid.UUID, lastEventID uint64) (*LiveSubscription, []model.LiveUpdate, error) {
+	if _, err := s.venueRepo.GetByID(ctx, venueID); err != nil {
+		return nil, nil, fmt.Errorf("venue not found: %w", err)
+	}
+
+	sub, missed, resumed := s.hub.subscribeVenue(venueID, lastEventID)
+	if resumed {
+		return sub, missed, nil
+	}
+
+	matches, err := s.matchRepo.ListInProgressByVenue(ctx, venueID)
+	if err != nil {
+		s.hub.unsubscribe(sub)
+		return nil, nil, err
+	}
+
+	snapshots := make([]model.LiveUpdate, 0, len(matches))
+	for _, m := range matches {
+		state, err := s.GetLiveState(ctx, m.ID)
+		if err != nil {
+			s.hub.unsubscribe(sub)
+			return nil, nil, err
+		}
+		snapshots = append(snapshots, model.LiveUpdate{Type: model.LiveUpdateSnapshot, State: state})
+	}
+	return sub, snapshots, nil
+}
+
+// Unsubscribe ends a live subscription.
+func (s *MatchService) Unsubscribe(sub *LiveSubscription) {
+	s.hub.unsubscribe(sub)
+}
+
+// GetLiveState returns the current score of a match.
+// The state is served from the live cache, replaying stored events on a miss.
+func (s *MatchService) GetLiveState(ctx context.Context, matchID uuid.UUID) (*model.LiveMatchState, error) {
+	if state, ok := s.live.get(matchID); ok {
+		return state, nil
+	}
+
+	gen := s.live.generation(matchID)
+
+	replay, err := s.loadReplay(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.live.store(matchID, gen, replay)
+	return replay.snapshot(), nil
+}
+
+// loadReplay replays all stored events of a match.
+func (s *MatchService) loadReplay(ctx context.Context, matchID uuid.UUID) (*matchReplay, error) {
+	match, err := s.matchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("match not found: %w", err)
+	}
+
+	players, err := s.matchRepo.GetMatchPlayers(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get players: %w", err)
+	}
+
+	events, err := s.matchRepo.GetEvents(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+
+	replay, err := newMatchReplay(match, players)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range events {
+		replay.apply(e)
+	}
+	return replay, nil
 }
 
 // GetMatchSummary computes statistics for a match.
@@ -154,152 +502,95 @@ func (s *MatchService) GetMatchSummary(ctx context.Context, matchID uuid.UUID) (
 		return nil, fmt.Errorf("failed to get events: %w", err)
 	}
 
-	// Build player map
-	playerMap := make(map[uuid.UUID]*model.Player)
-	playerTeamMap := make(map[uuid.UUID]model.Team)
+	suspensions, err := s.matchRepo.ListSuspensions(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get suspensions: %w", err)
+	}
+
+	// Look up player names
+	names := make(map[uuid.UUID]string)
 	for _, mp := range matchPlayers {
 		player, err := s.playerRepo.GetByID(ctx, mp.PlayerID)
 		if err != nil {
 			return nil, fmt.Errorf("player not found: %w", err)
 		}
-		playerMap[mp.PlayerID] = player
-		playerTeamMap[mp.PlayerID] = mp.Team
+		names[mp.PlayerID] = player.Name
 	}
 
-	// Compute stats
-	stats := make(map[uuid.UUID]*model.PlayerMatchStats)
-	for playerID, player := range playerMap {
-		stats[playerID] = &model.PlayerMatchStats{
-			PlayerID:   playerID,
-			PlayerName: player.Name,
-			Team:       playerTeamMap[playerID],
-		}
+	// Replay the points through the scoring engine for stats and sets
+	stats, err := computeMatchStats(match, matchPlayers, names, events)
+	if err != nil {
+		return nil, err
 	}
+	gamesA, gamesB := stats.gamesA, stats.gamesB
+	setsA, setsB := stats.replay.state.SetsA, stats.replay.state.SetsB
+	sets := stats.replay.sets
 
-	teamAScore := 0
-	teamBScore := 0
-
-	for _, event := range events {
-		serverStats := stats[event.ServerPlayerID]
-		if serverStats == nil {
-			continue
-		}
-
-		// Track serve stats
-		switch event.ServeType {
-		case model.ServeTypeFirst:
-			serverStats.FirstServesTotal++
-			serverStats.FirstServesIn++
-			if playerTeamMap[event.ServerPlayerID] == event.PointWinnerTeam {
-				serverStats.FirstServeWon++
-			}
-		case model.ServeTypeSecond:
-			serverStats.FirstServesTotal++ // First serve was out
-			serverStats.SecondServesTotal++
-			serverStats.SecondServesIn++
-			if playerTeamMap[event.ServerPlayerID] == event.PointWinnerTeam {
-				serverStats.SecondServeWon++
-			}
-		case model.ServeTypeDoubleFault:
-			serverStats.FirstServesTotal++
-			serverStats.SecondServesTotal++
-			serverStats.DoubleFaults++
-		}
+	now := time.Now()
+	pace, err := computeMatchPace(match, matchPlayers, events, suspensions, now)
+	if err != nil {
+		return nil, err
+	}
 
-		// Track point winner
-		if event.PointWinnerTeam == model.TeamA {
-			teamAScore++
-		} else {
-			teamBScore++
+	// Result-only matches have no points; games and sets come from the score
+	if match.ResultOnly {
+		pace = nil
+		sets = resultSets(match)
+		score, err := storedScore(match)
+		if err != nil {
+			return nil, fmt.Errorf("invalid stored score: %w", err)
 		}
-
-		// Track total points won per player (for the winning team)
-		for playerID, team := range playerTeamMap {
-			if team == event.PointWinnerTeam {
-				stats[playerID].TotalPointsWon++
-			}
+		gamesA, gamesB = score.GamesWon()
+		if match.Mode != model.MatchModeShort {
+			setsA, setsB = score.SetsWon()
 		}
 	}
 
-	// Convert stats map to slice
-	var playerStats []model.PlayerMatchStats
-	for _, stat := range stats {
-		playerStats = append(playerStats, *stat)
-	}
-
-	// Compute games and sets by replaying events through scoring logic
-	gamesA, gamesB, setsA, setsB := computeGamesAndSets(events)
-
 	return &model.MatchSummary{
-		MatchID:     matchID,
-		Venue:       *venue,
-		MatchType:   match.MatchType,
-		StartedAt:   match.StartedAt,
-		EndedAt:     match.EndedAt,
-		TeamAScore:  teamAScore,
-		TeamBScore:  teamBScore,
-		GamesA:      gamesA,
-		GamesB:      gamesB,
-		SetsA:       setsA,
-		SetsB:       setsB,
-		PlayerStats: playerStats,
+		MatchID:        matchID,
+		Venue:          *venue,
+		MatchType:      match.MatchType,
+		Mode:           match.Mode,
+		Status:         match.Status,
+		StartedAt:      match.StartedAt,
+		EndedAt:        match.EndedAt,
+		Suspensions:    suspensions,
+		PlayingSeconds: int64(playingDuration(match, suspensions, now).Seconds()),
+		TeamAScore:     stats.pointsA,
+		TeamBScore:     stats.pointsB,
+		GamesA:         gamesA,
+		GamesB:         gamesB,
+		SetsA:          setsA,
+		SetsB:          setsB,
+		Sets:           sets,
+		WinnerTeam:     match.WinnerTeam,
+		Score:          match.Score,
+		ResultOnly:     match.ResultOnly,
+		PlayerStats:    stats.players,
+		TeamStats:      stats.teams,
+		Pace:           pace,
 	}, nil
 }
 
-// computeGamesAndSets replays point events to calculate games and sets won.
-// Uses simplified tennis scoring logic to derive game/set counts from raw points.
-func computeGamesAndSets(events []model.PointEvent) (gamesA, gamesB, setsA, setsB int) {
-	// Track points in current game
-	pointsA := 0
-	pointsB := 0
-	
-	// Track games in current set
-	gamesInSetA := 0
-	gamesInSetB := 0
-	
-	for _, event := range events {
-		// Count point
-		if event.PointWinnerTeam == model.TeamA {
-			pointsA++
-		} else {
-			pointsB++
-		}
-		
-		// Check if game is won (simplified: 4 points with 2 point lead)
-		gameWonByA := pointsA >= 4 && pointsA-pointsB >= 2
-		gameWonByB := pointsB >= 4 && pointsB-pointsA >= 2
-		
-		if gameWonByA {
-			gamesA++
-			gamesInSetA++
-			pointsA = 0
-			pointsB = 0
-			
-			// Check if set is won (6 games with 2 game lead, or 7-5, or tiebreak 7-6)
-			if (gamesInSetA >= 6 && gamesInSetA-gamesInSetB >= 2) || gamesInSetA == 7 {
-				setsA++
-				gamesInSetA = 0
-				gamesInSetB = 0
-			}
-		} else if gameWonByB {
-			gamesB++
-			gamesInSetB++
-			pointsA = 0
-			pointsB = 0
-			
-			// Check if set is won
-			if (gamesInSetB >= 6 && gamesInSetB-gamesInSetA >= 2) || gamesInSetB == 7 {
-				setsB++
-				gamesInSetA = 0
-				gamesInSetB = 0
-			}
-		}
+// DeleteMatch removes a match. A tournament result it decided is taken
+// back in the same transaction; fails with tournament.ErrResultLocked if
+// later tournament matches depend on it.
+func (s *MatchService) DeleteMatch(ctx context.Context, matchID uuid.UUID) error {
+	rated, err := s.ratings.IsRated(ctx, matchID)
+	if err != nil {
+		return err
 	}
-	
-	return gamesA, gamesB, setsA, setsB
-}
 
-// DeleteMatch removes a match.
-func (s *MatchService) DeleteMatch(ctx context.Context, matchID uuid.UUID) error {
-	return s.matchRepo.Delete(ctx, matchID)
+	if err := s.deleteScoredMatch(ctx, matchID); err != nil {
+		return err
+	}
+
+	// The match's rating history went with it; later ratings built on it
+	if rated {
+		s.recomputeRatings(ctx)
+	}
+
+	s.live.invalidate(matchID)
+	s.hub.forget(matchID)
+	return nil
 }
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+)
+
+// slotRounding is the booking granularity suggested slots are rounded to.
+const slotRounding = 15 * time.Minute
+
+// GetMatchPace reports how long a match and each of its sets and games took.
+func (s *MatchService) GetMatchPace(ctx context.Context, matchID uuid.UUID) (*model.MatchPace, error) {
+	match, err := s.matchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("match not found: %w", err)
+	}
+
+	players, err := s.matchRepo.GetMatchPlayers(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get players: %w", err)
+	}
+
+	events, err := s.matchRepo.GetEvents(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+
+	suspensions, err := s.matchRepo.ListSuspensions(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get suspensions: %w", err)
+	}
+
+	return computeMatchPace(match, players, events, suspensions, time.Now())
+}
+
+// GetVenuePace aggregates the pace of completed, point-tracked matches at a
+// venue by scoring mode. Matches whose points carry no timing, such as
+// imports, are left out.
+func (s *MatchService) GetVenuePace(ctx context.Context, venueID uuid.UUID, from, to *time.Time) (*model.VenuePace, error) {
+	if _, err := s.venueRepo.GetByID(ctx, venueID); err != nil {
+		return nil, err
+	}
+
+	filter := repository.MatchFilter{
+		VenueID: &venueID,
+		Status:  model.MatchStatusCompleted,
+		From:    from,
+		To:      to,
+	}
+
+	suspensions, err := s.matchRepo.ListSuspensionsForMatches(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	byMode := make(map[model.MatchMode][]*model.MatchPace)
+	err = s.matchRepo.StreamMatches(ctx, filter, func(match model.Match, players []model.MatchPlayer, events []model.PointEvent) error {
+		if match.ResultOnly || len(events) < 2 {
+			return nil
+		}
+		pace, err := computeMatchPace(&match, players, events, suspensions[match.ID], time.Now())
+		if err != nil {
+			return err
+		}
+		if pace.PlayingSeconds > 0 && pace.AvgSecondsBetweenPoints > 0 {
+			byMode[match.Mode] = append(byMode[match.Mode], pace)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.VenuePace{VenueID: venueID, Modes: []model.ModePace{}}
+	for _, mode := range []model.MatchMode{model.MatchModeStandard, model.MatchModeShort} {
+		if paces := byMode[mode]; len(paces) > 0 {
+			result.Modes = append(result.Modes, aggregatePace(mode, paces))
+		}
+	}
+	return result, nil
+}
+
+// aggregatePace summarises the pace of matches of one mode.
+func aggregatePace(mode model.MatchMode, paces []*model.MatchPace) model.ModePace {
+	seconds := make([]int64, len(paces))
+	var total int64
+	var between float64
+	for i, p := range paces {
+		seconds[i] = p.PlayingSeconds
+		total += p.PlayingSeconds
+		between += p.AvgSecondsBetweenPoints
+	}
+	sort.Slice(seconds, func(i, j int) bool { return seconds[i] < seconds[j] })
+
+	p90 := percentile(seconds, 0.9)
+	slot := time.Duration(p90) * time.Second
+	slots := int(math.Ceil(float64(slot) / float64(slotRounding)))
+
+	return model.ModePace{
+		Mode:                    mode,
+		Matches:                 len(paces),
+		AvgPlayingSeconds:       total / int64(len(paces)),
+		MedianPlayingSeconds:    percentile(seconds, 0.5),
+		P90PlayingSeconds:       p90,
+		AvgSecondsBetweenPoints: between / float64(len(paces)),
+		SuggestedSlotMinutes:    slots * int(slotRounding/time.Minute),
+	}
+}
+
+// percentile returns the nearest-rank percentile of sorted values.
+func percentile(sorted []int64, p float64) int64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+// computeMatchPace times a match from its point timestamps. Each point is
+// timed from the end of the point before it; suspended time is left out.
+// Points after the scoring engine decided the match are not timed.
+func computeMatchPace(match *model.Match, players []model.MatchPlayer, events []model.PointEvent, suspensions []model.MatchSuspension, now time.Time) (*model.MatchPace, error) {
+	pace := &model.MatchPace{
+		MatchID:        match.ID,
+		Mode:           match.Mode,
+		PlayingSeconds: int64(playingDuration(match, suspensions, now).Seconds()),
+		Sets:           []model.SetPace{},
+	}
+	if match.ResultOnly {
+		return pace, nil
+	}
+
+	replay, err := newMatchReplay(match, players)
+	if err != nil {
+		return nil, err
+	}
+
+	// Games and sets are timed in durations, converted to seconds once at
+	// the end so each gap isn't truncated on its own
+	var games []*model.GamePace
+	var gameTimes, setTimes []time.Duration
+	var game *model.GamePace
+	var set *model.SetPace
+	var gaps time.Duration
+	timed := 0
+
+	for i, event := range events {
+		prev := replay.state
+		if prev.Completed {
+			break
+		}
+		replay.apply(event)
+		next := replay.state
+		if next == prev {
+			continue
+		}
+		pace.Points++
+
+		if set == nil || set.Set != prev.CurrentSet {
+			pace.Sets = append(pace.Sets, model.SetPace{Set: prev.CurrentSet})
+			setTimes = append(setTimes, 0)
+			set = &pace.Sets[len(pace.Sets)-1]
+		}
+		if game == nil {
+			game = &model.GamePace{Set: prev.CurrentSet, Game: prev.CurrentGame.GameNumber}
+			games = append(games, game)
+			gameTimes = append(gameTimes, 0)
+			set.Games++
+		}
+
+		var gap time.Duration
+		if i > 0 {
+			gap = playedBetween(events[i-1].Timestamp, event.Timestamp, suspensions)
+			gaps += gap
+			timed++
+		}
+		game.Points++
+		gameTimes[len(gameTimes)-1] += gap
+		set.Points++
+		setTimes[len(setTimes)-1] += gap
+
+		if gameEnded(prev, next) {
+			game = nil
+		}
+	}
+
+	if timed > 0 {
+		pace.AvgSecondsBetweenPoints = gaps.Seconds() / float64(timed)
+	}
+	for i := range pace.Sets {
+		pace.Sets[i].Seconds = int64(setTimes[i].Seconds())
+	}
+	for i, g := range games {
+		g.Seconds = int64(gameTimes[i].Seconds())
+		if pace.LongestGame == nil || g.Seconds > pace.LongestGame.Seconds {
+			pace.LongestGame = g
+		}
+	}
+	return pace, nil
+}
+
+// playedBetween returns the time between two instants that play wasn't
+// suspended.
+func playedBetween(from, to time.Time, suspensions []model.MatchSuspension) time.Duration {
+	played := to.Sub(from)
+	for _, sp := range suspensions {
+		start := sp.SuspendedAt
+		if start.Before(from) {
+			start = from
+		}
+		end := to
+		if sp.ResumedAt != nil && sp.ResumedAt.Before(to) {
+			end = *sp.ResumedAt
+		}
+		if end.After(start) {
+			played -= end.Sub(start)
+		}
+	}
+	if played < 0 {
+		return 0
+	}
+	return played
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+)
+
+func TestComputeMatchPace(t *testing.T) {
+	match, players := newTestMatch(model.MatchModeStandard)
+	start := time.Date(2024, 6, 1, 18, 0, 0, 0, time.UTC)
+	match.StartedAt = start
+
+	// A takes the first set 6-0 and the second 1-0; points are one
+	// second apart except for a 40 second point in game 3
+	events := pointEvents(match.ID, players[0].PlayerID, start, strings.Repeat("AAAA", 7))
+	for i := 9; i < len(events); i++ {
+		events[i].Timestamp = events[i].Timestamp.Add(40 * time.Second)
+	}
+
+	pace, err := computeMatchPace(match, players, events, nil, start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("computeMatchPace: %v", err)
+	}
+
+	if pace.Points != 28 {
+		t.Errorf("expected 28 points, got %d", pace.Points)
+	}
+	if want := 67.0 / 27; pace.AvgSecondsBetweenPoints != want {
+		t.Errorf("expected %.2fs between points, got %.2f", want, pace.AvgSecondsBetweenPoints)
+	}
+	if len(pace.Sets) != 2 || pace.Sets[0].Games != 6 || pace.Sets[0].Seconds != 63 || pace.Sets[1].Seconds != 4 {
+		t.Errorf("unexpected sets: %+v", pace.Sets)
+	}
+	if g := pace.LongestGame; g == nil || g.Set != 1 || g.Game != 3 || g.Seconds != 44 {
+		t.Errorf("expected the longest game to be set 1 game 3 at 44s, got %+v", g)
+	}
+	if pace.PlayingSeconds != 3600 {
+		t.Errorf("expected an hour of play, got %ds", pace.PlayingSeconds)
+	}
+}
+
+func TestComputeMatchPaceSubSecondGaps(t *testing.T) {
+	match, players := newTestMatch(model.MatchModeStandard)
+	start := time.Date(2024, 6, 1, 18, 0, 0, 0, time.UTC)
+
+	// A love game with points 1.5 seconds apart
+	events := pointEvents(match.ID, players[0].PlayerID, start, "AAAA")
+	for i := range events {
+		events[i].Timestamp = start.Add(time.Duration(i) * 1500 * time.Millisecond)
+	}
+
+	pace, err := computeMatchPace(match, players, events, nil, start.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("computeMatchPace: %v", err)
+	}
+	if g := pace.LongestGame; g == nil || g.Seconds != 4 || pace.Sets[0].Seconds != 4 {
+		t.Errorf("expected the 4.5s game to count as 4s, got %+v in %+v", g, pace.Sets)
+	}
+}
+
+func TestPlayedBetweenSkipsSuspensions(t *testing.T) {
+	start := time.Date(2024, 6, 1, 18, 0, 0, 0, time.UTC)
+	resumed := start.Add(50 * time.Minute)
+	suspensions := []model.MatchSuspension{{SuspendedAt: start.Add(10 * time.Second), ResumedAt: &resumed}}
+
+	if got := playedBetween(start, resumed.Add(20*time.Second), suspensions); got != 30*time.Second {
+		t.Errorf("expected 30s of play around the suspension, got %s", got)
+	}
+	if got := playedBetween(start, start.Add(5*time.Second), suspensions); got != 5*time.Second {
+		t.Errorf("expected 5s of play before the suspension, got %s", got)
+	}
+}
+
+func TestAggregatePace(t *testing.T) {
+	var paces []*model.MatchPace
+	for _, minutes := range []int64{50, 60, 70, 80, 100} {
+		paces = append(paces, &model.MatchPace{PlayingSeconds: minutes * 60, AvgSecondsBetweenPoints: 30})
+	}
+
+	agg := aggregatePace(model.MatchModeStandard, paces)
+	if agg.Matches != 5 || agg.MedianPlayingSeconds != 70*60 || agg.P90PlayingSeconds != 100*60 {
+		t.Errorf("unexpected aggregate: %+v", agg)
+	}
+	if agg.SuggestedSlotMinutes != 105 {
+		t.Errorf("expected a 105 minute slot, got %d", agg.SuggestedSlotMinutes)
+	}
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/tournament"
+)
+
+// DefaultPairingLookback is how far back team generation looks for repeat
+// partners and opponents: four weekly socials.
+const DefaultPairingLookback = 28 * 24 * time.Hour
+
+// GetPairingHistory returns who partnered and faced whom in doubles in the
+// lookback before a point in time, for team generation. Anchoring the
+// window on e.g. the tournament's creation keeps the teams reproducible
+// from the seed.
+func (s *MatchService) GetPairingHistory(ctx context.Context, before time.Time, lookback time.Duration) (*tournament.PairingHistory, error) {
+	since := before.Add(-lookback)
+	lineups, err := s.matchRepo.ListDoublesLineups(ctx, since, before)
+	if err != nil {
+		return nil, err
+	}
+	return tournament.NewPairingHistory(pastMatches(lineups), since), nil
+}
+
+// pastMatches converts lineups to the tournament engine's past matches.
+func pastMatches(lineups []repository.MatchLineup) []tournament.PastMatch {
+	matches := make([]tournament.PastMatch, len(lineups))
+	for i, l := range lineups {
+		matches[i].PlayedAt = l.StartedAt
+		for _, mp := range l.Players {
+			if mp.Team == model.TeamA {
+				matches[i].TeamA = append(matches[i].TeamA, mp.PlayerID)
+			} else {
+				matches[i].TeamB = append(matches[i].TeamB, mp.PlayerID)
+			}
+		}
+	}
+	return matches
+}
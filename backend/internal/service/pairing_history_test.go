@@ -0,0 +1,37 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/tournament"
+)
+
+func TestPastMatches(t *testing.T) {
+	a1, a2, b1 := uuid.New(), uuid.New(), uuid.New()
+	played := time.Date(2024, 6, 1, 18, 0, 0, 0, time.UTC)
+
+	lineups := []repository.MatchLineup{{
+		MatchID:   uuid.New(),
+		StartedAt: played,
+		Players: []model.MatchPlayer{
+			{PlayerID: a1, Team: model.TeamA},
+			{PlayerID: b1, Team: model.TeamB},
+			{PlayerID: a2, Team: model.TeamA},
+		},
+	}}
+
+	history := tournament.NewPairingHistory(pastMatches(lineups), played)
+	if history.Partnered(a1, a2) != 1 {
+		t.Error("expected the A side to count as partners")
+	}
+	if history.Faced(a1, b1) != 1 || history.Faced(a2, b1) != 1 {
+		t.Error("expected both A players to have faced B")
+	}
+	if history.Partnered(a1, b1) != 0 {
+		t.Error("expected opponents not to count as partners")
+	}
+}
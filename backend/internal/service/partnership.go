@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/rating"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+)
+
+// pairRecord is a doubles pair's record together.
+type pairRecord struct {
+	player1, player2 uuid.UUID
+	record           model.WinLoss
+}
+
+// GetPartnerships returns every doubles pair with at least minMatches
+// completed matches together, across all venues, best chemistry first.
+func (s *RatingService) GetPartnerships(ctx context.Context, minMatches int) ([]model.Partnership, error) {
+	partnerships, err := s.partnerships(ctx, minMatches)
+	if err != nil {
+		return nil, err
+	}
+	sortPartnerships(partnerships)
+	return partnerships, nil
+}
+
+// GetPlayerPartners returns a player's partnerships with at least
+// minMatches matches together, the partners they win with most first.
+func (s *RatingService) GetPlayerPartners(ctx context.Context, playerID uuid.UUID, minMatches int) (*model.PlayerPartners, error) {
+	player, err := s.playerRepo.GetByID(ctx, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("player not found: %w", err)
+	}
+
+	all, err := s.partnerships(ctx, minMatches)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.PlayerPartners{PlayerID: playerID, PlayerName: player.Name, Partnerships: []model.Partnership{}}
+	for _, p := range all {
+		switch playerID {
+		case p.Player1ID:
+		case p.Player2ID:
+			p.Player1ID, p.Player2ID = p.Player2ID, p.Player1ID
+			p.Player1Name, p.Player2Name = p.Player2Name, p.Player1Name
+			p.Player1Apart, p.Player2Apart = p.Player2Apart, p.Player1Apart
+		default:
+			continue
+		}
+		result.Partnerships = append(result.Partnerships, p)
+	}
+	sortPartnerships(result.Partnerships)
+	return result, nil
+}
+
+// partnerships builds the partnerships with at least minMatches matches
+// together, in no particular order.
+func (s *RatingService) partnerships(ctx context.Context, minMatches int) ([]model.Partnership, error) {
+	matches, err := s.ratingRepo.ListRatedMatches(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pairs, players := computePartnerships(matches)
+
+	var ids []uuid.UUID
+	for id := range players {
+		ids = append(ids, id)
+	}
+	stored, err := s.ratingRepo.Get(ctx, ids, model.RatingKindDoubles)
+	if err != nil {
+		return nil, err
+	}
+	ratings := make(map[uuid.UUID]rating.Rating, len(stored))
+	for _, pr := range stored {
+		ratings[pr.PlayerID] = fromPlayerRating(pr).Rating
+	}
+
+	all, err := s.playerRepo.List(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[uuid.UUID]string, len(all))
+	for _, p := range all {
+		names[p.ID] = p.Name
+	}
+
+	result := []model.Partnership{}
+	for key, pair := range pairs {
+		if pair.record.Matches < minMatches {
+			continue
+		}
+		result = append(result, buildPartnership(key, pair, players, ratings, names))
+	}
+	return result, nil
+}
+
+// computePartnerships tallies completed doubles matches by pair, keyed by
+// formatTeamID, and each player's whole doubles record.
+func computePartnerships(matches []repository.RatedMatch) (map[string]*pairRecord, map[uuid.UUID]*model.WinLoss) {
+	pairs := make(map[string]*pairRecord)
+	players := make(map[uuid.UUID]*model.WinLoss)
+
+	for _, m := range matches {
+		if m.MatchType != model.MatchTypeDoubles {
+			continue
+		}
+		sides := make(map[model.Team][]uuid.UUID)
+		for _, mp := range m.Players {
+			sides[mp.Team] = append(sides[mp.Team], mp.PlayerID)
+		}
+
+		for team, ids := range sides {
+			result := "L"
+			if team == m.WinnerTeam {
+				result = "W"
+			}
+			for _, id := range ids {
+				countResult(lookupRecord(players, id), result)
+			}
+			if len(ids) != 2 {
+				continue
+			}
+
+			key := formatTeamID(ids[0], ids[1])
+			pair, ok := pairs[key]
+			if !ok {
+				pair = &pairRecord{player1: ids[0], player2: ids[1]}
+				if ids[1].String() < ids[0].String() {
+					pair.player1, pair.player2 = ids[1], ids[0]
+				}
+				pairs[key] = pair
+			}
+			countResult(&pair.record, result)
+		}
+	}
+	return pairs, players
+}
+
+// buildPartnership works out a pair's records apart, chemistry and team
+// rating. Players without a stored doubles rating count at the initial one.
+func buildPartnership(key string, pair *pairRecord, players map[uuid.UUID]*model.WinLoss, ratings map[uuid.UUID]rating.Rating, names map[uuid.UUID]string) model.Partnership {
+	p := model.Partnership{
+		TeamID:        key,
+		Player1ID:     pair.player1,
+		Player2ID:     pair.player2,
+		Player1Name:   names[pair.player1],
+		Player2Name:   names[pair.player2],
+		WinLoss:       pair.record,
+		WinPercentage: winPercentage(pair.record),
+		Player1Apart:  apartRecord(players[pair.player1], pair.record),
+		Player2Apart:  apartRecord(players[pair.player2], pair.record),
+	}
+
+	if p.Matches > 0 && p.Player1Apart.Matches > 0 && p.Player2Apart.Matches > 0 {
+		chemistry := p.WinPercentage - (p.Player1Apart.WinPercentage+p.Player2Apart.WinPercentage)/2
+		p.Chemistry = &chemistry
+	}
+
+	team := make([]rating.Rating, 0, 2)
+	for _, id := range []uuid.UUID{pair.player1, pair.player2} {
+		r, ok := ratings[id]
+		if !ok {
+			r = rating.Initial()
+		}
+		team = append(team, r)
+	}
+	combined := rating.Team(team...)
+	p.TeamRating = combined.Rating
+	p.TeamDeviation = combined.Deviation
+	return p
+}
+
+// apartRecord is a player's doubles record less their matches together.
+func apartRecord(total *model.WinLoss, together model.WinLoss) model.PartnerRecord {
+	var apart model.WinLoss
+	if total != nil {
+		apart = model.WinLoss{
+			Matches: total.Matches - together.Matches,
+			Wins:    total.Wins - together.Wins,
+			Losses:  total.Losses - together.Losses,
+		}
+	}
+	return model.PartnerRecord{WinLoss: apart, WinPercentage: winPercentage(apart)}
+}
+
+func winPercentage(r model.WinLoss) float64 {
+	if r.Matches == 0 {
+		return 0
+	}
+	return float64(r.Wins) / float64(r.Matches) * 100
+}
+
+// sortPartnerships orders pairs by chemistry, then win percentage, then
+// matches together. Pairs without a chemistry figure go last.
+func sortPartnerships(partnerships []model.Partnership) {
+	sort.Slice(partnerships, func(i, j int) bool {
+		a, b := partnerships[i], partnerships[j]
+		if (a.Chemistry == nil) != (b.Chemistry == nil) {
+			return a.Chemistry != nil
+		}
+		if a.Chemistry != nil && *a.Chemistry != *b.Chemistry {
+			return *a.Chemistry > *b.Chemistry
+		}
+		if a.WinPercentage != b.WinPercentage {
+			return a.WinPercentage > b.WinPercentage
+		}
+		if a.Matches != b.Matches {
+			return a.Matches > b.Matches
+		}
+		return a.TeamID < b.TeamID
+	})
+}
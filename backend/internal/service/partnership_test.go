@@ -0,0 +1,83 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/rating"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+)
+
+func doublesMatch(winner model.Team, teamA, teamB [2]uuid.UUID) repository.RatedMatch {
+	m := repository.RatedMatch{ID: uuid.New(), MatchType: model.MatchTypeDoubles, StartedAt: time.Now(), WinnerTeam: winner}
+	for _, id := range teamA {
+		m.Players = append(m.Players, model.MatchPlayer{MatchID: m.ID, PlayerID: id, Team: model.TeamA})
+	}
+	for _, id := range teamB {
+		m.Players = append(m.Players, model.MatchPlayer{MatchID: m.ID, PlayerID: id, Team: model.TeamB})
+	}
+	return m
+}
+
+func TestComputePartnerships(t *testing.T) {
+	p1, p2, p3, p4, p5, p6 := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	matches := []repository.RatedMatch{
+		// p1 and p2 win both matches together...
+		doublesMatch(model.TeamA, [2]uuid.UUID{p1, p2}, [2]uuid.UUID{p3, p4}),
+		doublesMatch(model.TeamB, [2]uuid.UUID{p5, p6}, [2]uuid.UUID{p2, p1}),
+		// ...but each loses with someone else
+		doublesMatch(model.TeamB, [2]uuid.UUID{p1, p3}, [2]uuid.UUID{p5, p6}),
+		doublesMatch(model.TeamA, [2]uuid.UUID{p5, p4}, [2]uuid.UUID{p2, p6}),
+	}
+	// Singles doesn't count
+	singles := doublesMatch(model.TeamA, [2]uuid.UUID{p1, p2}, [2]uuid.UUID{p3, p4})
+	singles.MatchType = model.MatchTypeSingles
+	matches = append(matches, singles)
+
+	pairs, players := computePartnerships(matches)
+	key := formatTeamID(p1, p2)
+	pair := pairs[key]
+	if pair == nil || pair.record.Matches != 2 || pair.record.Wins != 2 {
+		t.Fatalf("expected p1 and p2 to be 2-0 together, got %+v", pair)
+	}
+	if players[p1].Matches != 3 {
+		t.Errorf("expected p1 to have 3 doubles matches, got %d", players[p1].Matches)
+	}
+
+	ratings := map[uuid.UUID]rating.Rating{p1: {Rating: 1700, Deviation: 50, Volatility: 0.06}}
+	names := map[uuid.UUID]string{p1: "One", p2: "Two"}
+	p := buildPartnership(key, pair, players, ratings, names)
+
+	if p.Player1Apart.Matches != 1 || p.Player1Apart.Wins != 0 || p.Player2Apart.Losses != 1 {
+		t.Errorf("unexpected records apart: %+v / %+v", p.Player1Apart, p.Player2Apart)
+	}
+	if p.Chemistry == nil || *p.Chemistry != 100 {
+		t.Errorf("expected chemistry of 100 points, got %v", p.Chemistry)
+	}
+	if p.TeamRating != 1600 {
+		t.Errorf("expected a team rating of 1600 with p2 unrated, got %.1f", p.TeamRating)
+	}
+
+	// A pair whose players never played apart has no chemistry yet
+	only, onlyPlayers := computePartnerships(matches[:1])
+	fresh := buildPartnership(key, only[key], onlyPlayers, nil, names)
+	if fresh.Chemistry != nil || fresh.TeamRating != rating.DefaultRating {
+		t.Errorf("expected no chemistry and the initial team rating, got %v and %.1f", fresh.Chemistry, fresh.TeamRating)
+	}
+}
+
+func TestSortPartnerships(t *testing.T) {
+	high, low := 20.0, -5.0
+	partnerships := []model.Partnership{
+		{TeamID: "none", WinPercentage: 100},
+		{TeamID: "low", Chemistry: &low},
+		{TeamID: "high", Chemistry: &high},
+	}
+	sortPartnerships(partnerships)
+	if partnerships[0].TeamID != "high" || partnerships[1].TeamID != "low" || partnerships[2].TeamID != "none" {
+		t.Errorf("unexpected order: %s, %s, %s", partnerships[0].TeamID, partnerships[1].TeamID, partnerships[2].TeamID)
+	}
+}
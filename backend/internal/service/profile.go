@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+)
+
+const (
+	// profileFormMatches is the number of recent matches in a profile's form
+	profileFormMatches = 10
+
+	// profileTopPlayers caps the partners and opponents in a profile
+	profileTopPlayers = 5
+)
+
+// GetPlayerProfile aggregates a player's completed matches. Serve numbers
+// come from the same computation as the match summaries, so they add up
+// to the per-match figures; result-only matches count in records only.
+func (s *MatchService) GetPlayerProfile(ctx context.Context, playerID uuid.UUID) (*model.PlayerProfile, error) {
+	player, err := s.playerRepo.GetByID(ctx, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("player not found: %w", err)
+	}
+
+	players, err := s.playerRepo.List(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[uuid.UUID]string, len(players))
+	for _, p := range players {
+		names[p.ID] = p.Name
+	}
+
+	venues, err := s.venueRepo.List(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	venueSurfaces := make(map[uuid.UUID]model.Surface, len(venues))
+	for _, v := range venues {
+		venueSurfaces[v.ID] = v.Surface
+	}
+	courtSurfaces := make(map[uuid.UUID]*model.Surface)
+
+	filter := repository.MatchFilter{
+		PlayerID: &playerID,
+		Status:   model.MatchStatusCompleted,
+	}
+
+	agg := newProfileAggregator(playerID)
+	err = s.matchRepo.StreamMatches(ctx, filter, func(match model.Match, matchPlayers []model.MatchPlayer, events []model.PointEvent) error {
+		surface := venueSurfaces[match.VenueID]
+		if match.CourtID != nil {
+			override, ok := courtSurfaces[*match.CourtID]
+			if !ok {
+				court, err := s.courtRepo.GetByID(ctx, *match.CourtID)
+				if err != nil {
+					return fmt.Errorf("failed to get court: %w", err)
+				}
+				override = court.Surface
+				courtSurfaces[*match.CourtID] = override
+			}
+			if override != nil {
+				surface = *override
+			}
+		}
+
+		var own *model.PlayerMatchStats
+		if !match.ResultOnly {
+			stats, err := computeMatchStats(&match, matchPlayers, names, events)
+			if err != nil {
+				return err
+			}
+			for i := range stats.players {
+				if stats.players[i].PlayerID == playerID {
+					own = &stats.players[i]
+				}
+			}
+		}
+
+		agg.add(&match, matchPlayers, surface, own)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return agg.profile(*player, names), nil
+}
+
+// profileAggregator accumulates a player's matches, newest first, into a
+// profile.
+type profileAggregator struct {
+	playerID uuid.UUID
+
+	career    model.WinLoss
+	byType    map[model.MatchType]*model.WinLoss
+	bySurface map[model.Surface]*surfaceTotals
+	partners  map[uuid.UUID]*model.WinLoss
+	opponents map[uuid.UUID]*model.WinLoss
+	serve     serveTotals
+	months    map[string]*serveTotals
+	form      []model.FormEntry
+}
+
+// surfaceTotals is a player's record and serve on one surface.
+type surfaceTotals struct {
+	record model.WinLoss
+	serve  serveTotals
+}
+
+func newProfileAggregator(playerID uuid.UUID) *profileAggregator {
+	return &profileAggregator{
+		playerID:  playerID,
+		byType:    make(map[model.MatchType]*model.WinLoss),
+		bySurface: make(map[model.Surface]*surfaceTotals),
+		partners:  make(map[uuid.UUID]*model.WinLoss),
+		opponents: make(map[uuid.UUID]*model.WinLoss),
+		months:    make(map[string]*serveTotals),
+		form:      []model.FormEntry{},
+	}
+}
+
+// add counts one completed match. own is the player's stats from
+// computeMatchStats, or nil for a result-only match.
+func (a *profileAggregator) add(match *model.Match, players []model.MatchPlayer, surface model.Surface, own *model.PlayerMatchStats) {
+	var team model.Team
+	for _, mp := range players {
+		if mp.PlayerID == a.playerID {
+			team = mp.Team
+		}
+	}
+	if team == "" {
+		return
+	}
+
+	result := ""
+	if match.WinnerTeam != nil {
+		result = "L"
+		if *match.WinnerTeam == team {
+			result = "W"
+		}
+	}
+
+	countResult(&a.career, result)
+	byType, ok := a.byType[match.MatchType]
+	if !ok {
+		byType = &model.WinLoss{}
+		a.byType[match.MatchType] = byType
+	}
+	countResult(byType, result)
+	st, ok := a.bySurface[surface]
+	if !ok {
+		st = &surfaceTotals{}
+		a.bySurface[surface] = st
+	}
+	countResult(&st.record, result)
+
+	for _, mp := range players {
+		switch {
+		case mp.PlayerID == a.playerID:
+		case mp.Team == team:
+			countResult(lookupRecord(a.partners, mp.PlayerID), result)
+		default:
+			countResult(lookupRecord(a.opponents, mp.PlayerID), result)
+		}
+	}
+
+	if own != nil {
+		mine := []model.PlayerMatchStats{*own}
+		a.serve.add(mine, team)
+		st.serve.add(mine, team)
+		month := match.StartedAt.UTC().Format("2006-01")
+		totals, ok := a.months[month]
+		if !ok {
+			totals = &serveTotals{}
+			a.months[month] = totals
+		}
+		totals.add(mine, team)
+	}
+
+	if len(a.form) < profileFormMatches {
+		score := match.Score
+		if team == model.TeamB {
+			score = flipScore(score)
+		}
+		a.form = append(a.form, model.FormEntry{
+			MatchID:   match.ID,
+			MatchType: match.MatchType,
+			StartedAt: match.StartedAt,
+			Result:    result,
+			Score:     score,
+		})
+	}
+}
+
+// profile builds the profile from the matches added so far.
+func (a *profileAggregator) profile(player model.Player, names map[uuid.UUID]string) *model.PlayerProfile {
+	profile := &model.PlayerProfile{
+		Player:      player,
+		WinLoss:     a.career,
+		ByMatchType: []model.MatchTypeRecord{},
+		BySurface:   []model.SurfaceRecord{},
+		Partners:    topProfilePlayers(a.partners, names),
+		Opponents:   topProfilePlayers(a.opponents, names),
+		Serve:       a.serve.averages(),
+		ServeTrend:  []model.ServeTrendPoint{},
+		Form:        a.form,
+	}
+
+	for _, matchType := range []model.MatchType{model.MatchTypeSingles, model.MatchTypeDoubles, model.MatchTypeAustralianDoubles} {
+		if record, ok := a.byType[matchType]; ok {
+			profile.ByMatchType = append(profile.ByMatchType, model.MatchTypeRecord{MatchType: matchType, WinLoss: *record})
+		}
+	}
+	for _, surface := range []model.Surface{model.SurfaceHard, model.SurfaceClay, model.SurfaceGrass} {
+		if st, ok := a.bySurface[surface]; ok {
+			profile.BySurface = append(profile.BySurface, model.SurfaceRecord{
+				Surface: surface,
+				WinLoss: st.record,
+				Serve:   st.serve.averages(),
+			})
+		}
+	}
+
+	months := make([]string, 0, len(a.months))
+	for month := range a.months {
+		months = append(months, month)
+	}
+	sort.Strings(months)
+	for _, month := range months {
+		profile.ServeTrend = append(profile.ServeTrend, model.ServeTrendPoint{
+			Month:         month,
+			ServeAverages: a.months[month].averages(),
+		})
+	}
+
+	return profile
+}
+
+// topProfilePlayers returns the players with the most matches, ties broken
+// by name.
+func topProfilePlayers(records map[uuid.UUID]*model.WinLoss, names map[uuid.UUID]string) []model.ProfilePlayerRecord {
+	result := make([]model.ProfilePlayerRecord, 0, len(records))
+	for id, record := range records {
+		result = append(result, model.ProfilePlayerRecord{PlayerID: id, PlayerName: names[id], WinLoss: *record})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Matches != result[j].Matches {
+			return result[i].Matches > result[j].Matches
+		}
+		return result[i].PlayerName < result[j].PlayerName
+	})
+	if len(result) > profileTopPlayers {
+		result = result[:profileTopPlayers]
+	}
+	return result
+}
+
+// lookupRecord returns the record for a player, adding an empty one if needed.
+func lookupRecord(records map[uuid.UUID]*model.WinLoss, key uuid.UUID) *model.WinLoss {
+	record, ok := records[key]
+	if !ok {
+		record = &model.WinLoss{}
+		records[key] = record
+	}
+	return record
+}
+
+// countResult adds a match with result "W", "L" or "" to a record.
+func countResult(record *model.WinLoss, result string) {
+	record.Matches++
+	switch result {
+	case "W":
+		record.Wins++
+	case "L":
+		record.Losses++
+	}
+}
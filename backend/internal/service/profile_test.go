@@ -0,0 +1,77 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+)
+
+func TestProfileAggregator(t *testing.T) {
+	me, partner, rival := uuid.New(), uuid.New(), uuid.New()
+	names := map[uuid.UUID]string{me: "Me", partner: "Partner", rival: "Rival"}
+	agg := newProfileAggregator(me)
+
+	// Newest first: a singles win on clay in June, tracked point by point...
+	match, players := newTestMatch(model.MatchModeStandard)
+	players[0].PlayerID, players[1].PlayerID = rival, me
+	match.StartedAt = time.Date(2024, 6, 10, 18, 0, 0, 0, time.UTC)
+	winner := model.TeamB
+	match.WinnerTeam, match.Score = &winner, "0-6 0-6"
+	events := pointEvents(match.ID, me, match.StartedAt, strings.Repeat("BBBB", 12))
+	stats, err := computeMatchStats(match, players, names, events)
+	if err != nil {
+		t.Fatalf("computeMatchStats: %v", err)
+	}
+	agg.add(match, players, model.SurfaceClay, &stats.players[1])
+
+	// ...then a result-only doubles loss on hard in May
+	lost := model.TeamA
+	doubles := &model.Match{
+		ID:         uuid.New(),
+		MatchType:  model.MatchTypeDoubles,
+		Mode:       model.MatchModeStandard,
+		StartedAt:  time.Date(2024, 5, 3, 18, 0, 0, 0, time.UTC),
+		WinnerTeam: &lost,
+		Score:      "6-3 6-4",
+		ResultOnly: true,
+	}
+	agg.add(doubles, []model.MatchPlayer{
+		{PlayerID: rival, Team: model.TeamA},
+		{PlayerID: uuid.New(), Team: model.TeamA},
+		{PlayerID: me, Team: model.TeamB},
+		{PlayerID: partner, Team: model.TeamB},
+	}, model.SurfaceHard, nil)
+
+	profile := agg.profile(model.Player{ID: me, Name: "Me"}, names)
+
+	if profile.Matches != 2 || profile.Wins != 1 || profile.Losses != 1 {
+		t.Errorf("expected 1-1 over 2 matches, got %+v", profile.WinLoss)
+	}
+	if len(profile.ByMatchType) != 2 || profile.ByMatchType[0].MatchType != model.MatchTypeSingles || profile.ByMatchType[0].Wins != 1 {
+		t.Errorf("unexpected match type records: %+v", profile.ByMatchType)
+	}
+	if len(profile.BySurface) != 2 || profile.BySurface[0].Surface != model.SurfaceHard || profile.BySurface[1].Serve.Matches != 1 {
+		t.Errorf("unexpected surface records: %+v", profile.BySurface)
+	}
+	if len(profile.Partners) != 1 || profile.Partners[0].PlayerName != "Partner" || profile.Partners[0].Losses != 1 {
+		t.Errorf("unexpected partners: %+v", profile.Partners)
+	}
+	if len(profile.Opponents) != 2 || profile.Opponents[0].PlayerName != "Rival" || profile.Opponents[0].Matches != 2 {
+		t.Errorf("unexpected opponents: %+v", profile.Opponents)
+	}
+
+	// Only the tracked match has serve numbers, matching its summary
+	if profile.Serve.Matches != 1 || profile.Serve.FirstServeInPct != 100 || profile.Serve.FirstServePointsWonPct != 100 {
+		t.Errorf("unexpected serve averages: %+v", profile.Serve)
+	}
+	if len(profile.ServeTrend) != 1 || profile.ServeTrend[0].Month != "2024-06" {
+		t.Errorf("unexpected serve trend: %+v", profile.ServeTrend)
+	}
+
+	if len(profile.Form) != 2 || profile.Form[0].Result != "W" || profile.Form[0].Score != "6-0 6-0" || profile.Form[1].Score != "3-6 4-6" {
+		t.Errorf("unexpected form: %+v", profile.Form)
+	}
+}
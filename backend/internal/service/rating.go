@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/rating"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+)
+
+// RatingService keeps the stored player ratings in step with completed
+// matches.
+type RatingService struct {
+	ratingRepo *repository.RatingRepository
+	playerRepo *repository.PlayerRepository
+
+	// mu serialises rating updates in this process so two matches
+	// completing together don't both start from the same ratings. Other
+	// processes are kept out by the ratings advisory lock.
+	mu sync.Mutex
+}
+
+// NewRatingService creates a new rating service.
+func NewRatingService(ratingRepo *repository.RatingRepository, playerRepo *repository.PlayerRepository) *RatingService {
+	return &RatingService{ratingRepo: ratingRepo, playerRepo: playerRepo}
+}
+
+// ListRatings returns the ratings table of a kind, highest first.
+func (s *RatingService) ListRatings(ctx context.Context, kind model.RatingKind) ([]model.PlayerRating, error) {
+	return s.ratingRepo.List(ctx, kind)
+}
+
+// GetRatingHistory returns a player's current ratings and how each rated
+// match moved them. An empty kind returns both kinds.
+func (s *RatingService) GetRatingHistory(ctx context.Context, playerID uuid.UUID, kind model.RatingKind) (*model.PlayerRatingHistory, error) {
+	if _, err := s.playerRepo.GetByID(ctx, playerID); err != nil {
+		return nil, fmt.Errorf("player not found: %w", err)
+	}
+
+	ratings, err := s.ratingRepo.ListForPlayer(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if kind != "" {
+		filtered := []model.PlayerRating{}
+		for _, r := range ratings {
+			if r.Kind == kind {
+				filtered = append(filtered, r)
+			}
+		}
+		ratings = filtered
+	}
+
+	history, err := s.ratingRepo.History(ctx, playerID, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.PlayerRatingHistory{PlayerID: playerID, Ratings: ratings, History: history}, nil
+}
+
+// IsRated reports whether a match has been applied to the ratings.
+func (s *RatingService) IsRated(ctx context.Context, matchID uuid.UUID) (bool, error) {
+	return s.ratingRepo.IsRated(ctx, matchID)
+}
+
+// lock serialises rating updates within this process and against others.
+// The returned function releases the lock.
+func (s *RatingService) lock(ctx context.Context) (func(), error) {
+	s.mu.Lock()
+	unlock, err := s.ratingRepo.Lock(ctx)
+	if err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	return func() {
+		unlock()
+		s.mu.Unlock()
+	}, nil
+}
+
+// ApplyMatch rates a newly completed match. A match played before the
+// latest rated one changes everything after it, so the ratings are
+// rebuilt from when it was played. Matches without a winner aren't rated.
+func (s *RatingService) ApplyMatch(ctx context.Context, match *model.Match, players []model.MatchPlayer) error {
+	if match.Status != model.MatchStatusCompleted || match.WinnerTeam == nil {
+		return nil
+	}
+
+	unlock, err := s.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	rated, err := s.ratingRepo.IsRated(ctx, match.ID)
+	if err != nil || rated {
+		return err
+	}
+	latest, err := s.ratingRepo.LatestPlayedAt(ctx)
+	if err != nil {
+		return err
+	}
+	if latest != nil && match.StartedAt.Before(*latest) {
+		return s.recomputeFrom(ctx, match.StartedAt)
+	}
+
+	m := ratedMatch(match.ID, match.MatchType, match.StartedAt, *match.WinnerTeam, players)
+	ids := append(append([]uuid.UUID{}, m.TeamA...), m.TeamB...)
+	stored, err := s.ratingRepo.Get(ctx, ids, model.RatingKind(m.Kind))
+	if err != nil {
+		return err
+	}
+
+	calc := rating.NewCalculator()
+	for _, pr := range stored {
+		calc.Set(fromPlayerRating(pr))
+	}
+	changes, err := calc.Apply(m)
+	if err != nil {
+		return fmt.Errorf("failed to rate match %s: %w", match.ID, err)
+	}
+
+	ratings := make([]model.PlayerRating, 0, len(changes))
+	for _, ch := range changes {
+		ratings = append(ratings, toPlayerRating(calc.Get(ch.PlayerID, ch.Kind)))
+	}
+	return s.ratingRepo.Save(ctx, ratings, toRatingChanges(changes))
+}
+
+// Recompute rebuilds every rating from scratch by replaying all completed
+// matches with a winner in the order they were played. Run it after
+// matches are edited, deleted or imported out of order.
+func (s *RatingService) Recompute(ctx context.Context) error {
+	unlock, err := s.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	matches, err := s.ratingRepo.ListRatedMatches(ctx)
+	if err != nil {
+		return err
+	}
+
+	ratings, changes := computeRatings(matches)
+	return s.ratingRepo.Replace(ctx, ratings, changes)
+}
+
+// recomputeFrom rebuilds the ratings from the matches played at or after a
+// time, starting from the ratings as they stood before it. The caller holds
+// the lock.
+func (s *RatingService) recomputeFrom(ctx context.Context, from time.Time) error {
+	stored, err := s.ratingRepo.RatingsBefore(ctx, from)
+	if err != nil {
+		return err
+	}
+	matches, err := s.ratingRepo.ListRatedMatchesFrom(ctx, from)
+	if err != nil {
+		return err
+	}
+
+	calc := rating.NewCalculator()
+	for _, pr := range stored {
+		calc.Set(fromPlayerRating(pr))
+	}
+	ratings, changes := replayRatings(calc, matches)
+	return s.ratingRepo.ReplaceFrom(ctx, from, ratings, changes)
+}
+
+// rateMatch applies a completed match to the ratings. players are loaded
+// when nil. Ratings can be recomputed at any time, so failures are logged
+// rather than failing the match.
+func (s *MatchService) rateMatch(ctx context.Context, match *model.Match, players []model.MatchPlayer) {
+	if players == nil {
+		var err error
+		if players, err = s.matchRepo.GetMatchPlayers(ctx, match.ID); err != nil {
+			log.Printf("failed to rate match %s: %v", match.ID, err)
+			return
+		}
+	}
+	if err := s.ratings.ApplyMatch(ctx, match, players); err != nil {
+		log.Printf("failed to rate match %s: %v", match.ID, err)
+	}
+}
+
+// recomputeRatings rebuilds the ratings after matches changed in the past.
+func (s *MatchService) recomputeRatings(ctx context.Context) {
+	if err := s.ratings.Recompute(ctx); err != nil {
+		log.Printf("failed to recompute ratings: %v", err)
+	}
+}
+
+// computeRatings replays matches, oldest first, from initial ratings.
+func computeRatings(matches []repository.RatedMatch) ([]model.PlayerRating, []model.RatingChange) {
+	return replayRatings(rating.NewCalculator(), matches)
+}
+
+// replayRatings applies matches, oldest first, to the ratings held by a
+// calculator. Matches the calculator rejects, such as ones missing a side,
+// are skipped.
+func replayRatings(calc *rating.Calculator, matches []repository.RatedMatch) ([]model.PlayerRating, []model.RatingChange) {
+	changes := []model.RatingChange{}
+	for _, m := range matches {
+		applied, err := calc.Apply(ratedMatch(m.ID, m.MatchType, m.StartedAt, m.WinnerTeam, m.Players))
+		if err != nil {
+			continue
+		}
+		changes = append(changes, toRatingChanges(applied)...)
+	}
+
+	ratings := []model.PlayerRating{}
+	for _, r := range calc.Ratings() {
+		ratings = append(ratings, toPlayerRating(r))
+	}
+	return ratings, changes
+}
+
+// ratingKind returns the rating a match type counts towards.
+func ratingKind(matchType model.MatchType) rating.Kind {
+	if matchType == model.MatchTypeSingles {
+		return rating.KindSingles
+	}
+	return rating.KindDoubles
+}
+
+func ratedMatch(id uuid.UUID, matchType model.MatchType, playedAt time.Time, winner model.Team, players []model.MatchPlayer) rating.Match {
+	m := rating.Match{
+		ID:       id,
+		Kind:     ratingKind(matchType),
+		PlayedAt: playedAt,
+		WinnerA:  winner == model.TeamA,
+	}
+	for _, mp := range players {
+		if mp.Team == model.TeamA {
+			m.TeamA = append(m.TeamA, mp.PlayerID)
+		} else {
+			m.TeamB = append(m.TeamB, mp.PlayerID)
+		}
+	}
+	return m
+}
+
+func fromPlayerRating(pr model.PlayerRating) rating.PlayerRating {
+	return rating.PlayerRating{
+		PlayerID:  pr.PlayerID,
+		Kind:      rating.Kind(pr.Kind),
+		Rating:    rating.Rating{Rating: pr.Rating, Deviation: pr.Deviation, Volatility: pr.Volatility},
+		Matches:   pr.Matches,
+		UpdatedAt: pr.UpdatedAt,
+	}
+}
+
+func toPlayerRating(r rating.PlayerRating) model.PlayerRating {
+	return model.PlayerRating{
+		PlayerID:   r.PlayerID,
+		Kind:       model.RatingKind(r.Kind),
+		Rating:     r.Rating.Rating,
+		Deviation:  r.Deviation,
+		Volatility: r.Volatility,
+		Matches:    r.Matches,
+		UpdatedAt:  r.UpdatedAt,
+	}
+}
+
+func toRatingChanges(changes []rating.Change) []model.RatingChange {
+	result := make([]model.RatingChange, len(changes))
+	for i, ch := range changes {
+		result[i] = model.RatingChange{
+			PlayerID:     ch.PlayerID,
+			MatchID:      ch.MatchID,
+			Kind:         model.RatingKind(ch.Kind),
+			PlayedAt:     ch.PlayedAt,
+			RatingBefore: ch.Before.Rating,
+			Rating:       ch.After.Rating,
+			Deviation:    ch.After.Deviation,
+			Volatility:   ch.After.Volatility,
+		}
+	}
+	return result
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/rating"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+)
+
+func TestComputeRatings(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	start := time.Date(2024, 6, 1, 18, 0, 0, 0, time.UTC)
+	players := func(teamA, teamB []uuid.UUID) []model.MatchPlayer {
+		var mps []model.MatchPlayer
+		for _, id := range teamA {
+			mps = append(mps, model.MatchPlayer{PlayerID: id, Team: model.TeamA})
+		}
+		for _, id := range teamB {
+			mps = append(mps, model.MatchPlayer{PlayerID: id, Team: model.TeamB})
+		}
+		return mps
+	}
+
+	matches := []repository.RatedMatch{
+		{ID: uuid.New(), MatchType: model.MatchTypeSingles, StartedAt: start, WinnerTeam: model.TeamA, Players: players([]uuid.UUID{a}, []uuid.UUID{b})},
+		{ID: uuid.New(), MatchType: model.MatchTypeAustralianDoubles, StartedAt: start.Add(time.Hour), WinnerTeam: model.TeamB, Players: players([]uuid.UUID{a}, []uuid.UUID{b, c})},
+		// A match missing a side is skipped
+		{ID: uuid.New(), MatchType: model.MatchTypeSingles, StartedAt: start.Add(2 * time.Hour), WinnerTeam: model.TeamA, Players: players([]uuid.UUID{a}, nil)},
+	}
+
+	ratings, changes := computeRatings(matches)
+	if len(changes) != 5 {
+		t.Fatalf("expected 5 rating changes, got %d", len(changes))
+	}
+	if changes[0].Kind != model.RatingKindSingles || changes[2].Kind != model.RatingKindDoubles {
+		t.Errorf("expected singles then doubles changes, got %s and %s", changes[0].Kind, changes[2].Kind)
+	}
+	if changes[0].PlayerID != a || changes[0].Rating <= changes[0].RatingBefore {
+		t.Errorf("expected the singles winner's rating to rise, got %+v", changes[0])
+	}
+
+	byKind := make(map[model.RatingKind]int)
+	for _, r := range ratings {
+		byKind[r.Kind]++
+		if r.Kind == model.RatingKindDoubles && r.PlayerID == a && (r.Rating >= 1500 || r.Matches != 1) {
+			t.Errorf("expected the lone 1v2 player to drop after one match, got %+v", r)
+		}
+	}
+	if byKind[model.RatingKindSingles] != 2 || byKind[model.RatingKindDoubles] != 3 {
+		t.Errorf("expected 2 singles and 3 doubles ratings, got %v", byKind)
+	}
+}
+
+func TestReplayRatingsFromMidway(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+	start := time.Date(2024, 6, 1, 18, 0, 0, 0, time.UTC)
+	var matches []repository.RatedMatch
+	for i := 0; i < 4; i++ {
+		winner := model.TeamA
+		if i%3 == 1 {
+			winner = model.TeamB
+		}
+		matches = append(matches, repository.RatedMatch{
+			ID: uuid.New(), MatchType: model.MatchTypeSingles, StartedAt: start.Add(time.Duration(i) * time.Hour), WinnerTeam: winner,
+			Players: []model.MatchPlayer{{PlayerID: a, Team: model.TeamA}, {PlayerID: b, Team: model.TeamB}},
+		})
+	}
+
+	// Rebuilding from the third match, starting from the ratings after the
+	// second, matches a full recompute
+	want, wantChanges := computeRatings(matches)
+	before, _ := computeRatings(matches[:2])
+	calc := rating.NewCalculator()
+	for _, pr := range before {
+		calc.Set(fromPlayerRating(pr))
+	}
+	got, changes := replayRatings(calc, matches[2:])
+
+	if len(changes) != 4 || changes[3] != wantChanges[7] {
+		t.Errorf("expected the last two matches' changes, got %+v", changes)
+	}
+	byPlayer := make(map[uuid.UUID]model.PlayerRating)
+	for _, r := range got {
+		byPlayer[r.PlayerID] = r
+	}
+	for _, r := range want {
+		if byPlayer[r.PlayerID] != r {
+			t.Errorf("expected %+v, got %+v", r, byPlayer[r.PlayerID])
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/scoring"
+)
+
+// matchReplay feeds stored point events through the scoring engine so the
+// backend can derive the same score the scoring device shows.
+type matchReplay struct {
+	match *model.Match
+	state *scoring.MatchState
+
+	// sets holds the final games of every completed set
+	sets []model.SetScore
+
+	// server is the player serving the game in progress (uuid.Nil if unknown)
+	server uuid.UUID
+
+	// applied tracks which events have already been replayed
+	applied map[uuid.UUID]bool
+
+	// lastSeq and lastTimestamp describe the most recently applied event
+	lastSeq       int
+	lastTimestamp time.Time
+}
+
+// newMatchReplay creates a replay positioned at the start of the match.
+func newMatchReplay(match *model.Match, players []model.MatchPlayer) (*matchReplay, error) {
+	var teams scoring.TeamPlayers
+	for _, mp := range players {
+		if mp.Team == model.TeamA {
+			teams.TeamA = append(teams.TeamA, mp.PlayerID.String())
+		} else {
+			teams.TeamB = append(teams.TeamB, mp.PlayerID.String())
+		}
+	}
+
+	mode := scoring.ModeStandard
+	var servers []string
+	if match.Mode == model.MatchModeShort {
+		mode = scoring.ModeShortFormat
+		for _, id := range match.Servers {
+			servers = append(servers, id.String())
+		}
+	}
+
+	state, err := scoring.NewMatchState(mode, teams, servers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialise scoring state: %w", err)
+	}
+	state.TieBreaks = match.TieBreaks
+	state.BestOf = match.BestOf
+
+	return &matchReplay{
+		match:   match,
+		state:   state,
+		sets:    []model.SetScore{},
+		applied: make(map[uuid.UUID]bool),
+	}, nil
+}
+
+// apply scores a single point event.
+// Points recorded after the engine has decided the match are ignored; the
+// scoring device is authoritative for when play actually stopped.
+func (r *matchReplay) apply(event model.PointEvent) {
+	if r.applied[event.ID] {
+		return
+	}
+	r.applied[event.ID] = true
+	r.lastSeq = event.Seq
+	r.lastTimestamp = event.Timestamp
+
+	if r.state.Completed {
+		return
+	}
+
+	prev := r.state
+	next, err := scoring.ScorePoint(prev, scoring.Team(event.PointWinnerTeam))
+	if err != nil {
+		return
+	}
+	r.state = next
+
+	// A set was completed on this point: record its final games
+	if next.SetsA+next.SetsB > prev.SetsA+prev.SetsB {
+		set := model.SetScore{GamesA: prev.GamesA, GamesB: prev.GamesB}
+		if event.PointWinnerTeam == model.TeamA {
+			set.GamesA++
+		} else {
+			set.GamesB++
+		}
+		if scoring.InTieBreak(prev) {
+			// The loser's points are unchanged by the winning point
+			loser := prev.CurrentGame.PointsB
+			if event.PointWinnerTeam == model.TeamB {
+				loser = prev.CurrentGame.PointsA
+			}
+			set.TieBreak = &loser
+		}
+		r.sets = append(r.sets, set)
+	}
+
+	// The server is only known until the game ends
+	if gameEnded(prev, next) {
+		r.server = uuid.Nil
+	} else {
+		r.server = event.ServerPlayerID
+	}
+}
+
+// snapshot returns the current live state of the match.
+func (r *matchReplay) snapshot() *model.LiveMatchState {
+	display := scoring.GetMatchDisplay(r.state)
+
+	live := &model.LiveMatchState{
+		MatchID:      r.match.ID,
+		VenueID:      r.match.VenueID,
+		Mode:         r.match.Mode,
+		Status:       r.match.Status,
+		Display:      display,
+		Sets:         append([]model.SetScore{}, r.sets...),
+		PointsPlayed: len(r.applied),
+		LastSeq:      r.lastSeq,
+		Decided:      r.state.Completed,
+		EndedAt:      r.match.EndedAt,
+		UpdatedAt:    time.Now(),
+	}
+
+	if r.state.Winner != nil {
+		winner := model.Team(*r.state.Winner)
+		live.Winner = &winner
+	}
+
+	// Result-only matches have no points to replay; report the stored result
+	if r.match.ResultOnly {
+		live.Sets = resultSets(r.match)
+		live.Winner = r.match.WinnerTeam
+		live.Decided = true
+		if score, err := storedScore(r.match); err == nil {
+			if r.match.Mode == model.MatchModeShort {
+				a, b := score.GamesWon()
+				live.Display.Games = scoring.ScoreCount{A: a, B: b}
+			} else {
+				a, b := score.SetsWon()
+				live.Display.Sets = &scoring.ScoreCount{A: a, B: b}
+			}
+		}
+	}
+
+	if display.Server != nil {
+		if id, err := uuid.Parse(*display.Server); err == nil {
+			live.ServerPlayerID = &id
+		}
+	} else if r.server != uuid.Nil {
+		server := r.server
+		live.ServerPlayerID = &server
+	}
+
+	return live
+}
+
+// gameEnded reports whether a game was completed between two states.
+func gameEnded(prev, next *scoring.MatchState) bool {
+	return next.GamesA+next.GamesB != prev.GamesA+prev.GamesB ||
+		next.SetsA+next.SetsB != prev.SetsA+prev.SetsB
+}
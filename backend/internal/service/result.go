@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/scoring"
+)
+
+// RecordResultRequest records a completed match by its final score only.
+type RecordResultRequest struct {
+	VenueID   uuid.UUID       `json:"venue_id"`
+	CourtID   *uuid.UUID      `json:"court_id,omitempty"`
+	MatchType model.MatchType `json:"match_type"`
+	Mode      model.MatchMode `json:"mode,omitempty"` // Defaults to standard
+	TeamA     []uuid.UUID     `json:"team_a"`
+	TeamB     []uuid.UUID     `json:"team_b"`
+
+	// Score is from Team A's side, e.g. "6-3 6-4" or "2-1" (short-format)
+	Score string `json:"score"`
+
+	// PlayedAt is when the match was played; defaults to now
+	PlayedAt *time.Time `json:"played_at,omitempty"`
+}
+
+// RecordResult stores a completed, result-only match. The score is
+// validated against the scoring rules of the match's mode and decides the
+// winner. Validation errors match ErrInvalidMatch.
+func (s *MatchService) RecordResult(ctx context.Context, req RecordResultRequest) (*model.Match, error) {
+	if err := s.validateMatchSetup(ctx, req.VenueID, req.CourtID, req.MatchType, req.TeamA, req.TeamB); err != nil {
+		return nil, invalidMatch(err)
+	}
+
+	mode := req.Mode
+	if mode == "" {
+		mode = model.MatchModeStandard
+	}
+	scoringMode := scoring.ModeStandard
+	if mode == model.MatchModeShort {
+		scoringMode = scoring.ModeShortFormat
+	}
+
+	score, err := scoring.ParseScore(scoringMode, req.Score)
+	if err != nil {
+		return nil, invalidMatchError{fmt.Errorf("invalid score: %w", err)}
+	}
+
+	// A short-format result is best of 5 when the winner needed 3 games
+	bestOf := 0
+	if mode == model.MatchModeShort {
+		gamesA, gamesB := score.GamesWon()
+		bestOf = 2*max(gamesA, gamesB) - 1
+	}
+
+	playedAt := time.Now()
+	if req.PlayedAt != nil {
+		playedAt = *req.PlayedAt
+	}
+
+	winner := model.Team(score.Winner)
+	match := &model.Match{
+		ID:         uuid.New(),
+		VenueID:    req.VenueID,
+		MatchType:  req.MatchType,
+		Mode:       mode,
+		BestOf:     bestOf,
+		CourtID:    req.CourtID,
+		Status:     model.MatchStatusCompleted,
+		StartedAt:  playedAt,
+		EndedAt:    &playedAt,
+		WinnerTeam: &winner,
+		Score:      score.String(),
+		ResultOnly: true,
+	}
+
+	var players []model.MatchPlayer
+	for _, id := range req.TeamA {
+		players = append(players, model.MatchPlayer{MatchID: match.ID, PlayerID: id, Team: model.TeamA})
+	}
+	for _, id := range req.TeamB {
+		players = append(players, model.MatchPlayer{MatchID: match.ID, PlayerID: id, Team: model.TeamB})
+	}
+
+	imported := []repository.ImportedMatch{{Match: match, Players: players}}
+	if err := s.matchRepo.Import(ctx, imported); err != nil {
+		return nil, fmt.Errorf("failed to record result: %w", err)
+	}
+	s.rateMatch(ctx, match, players)
+	return match, nil
+}
+
+// storedScore parses the stored score of a result-only match.
+func storedScore(match *model.Match) (*scoring.MatchScore, error) {
+	return parseScore(match.Mode, match.Score)
+}
+
+// parseScore parses a final score by the scoring rules of a match mode.
+func parseScore(mode model.MatchMode, score string) (*scoring.MatchScore, error) {
+	scoringMode := scoring.ModeStandard
+	if mode == model.MatchModeShort {
+		scoringMode = scoring.ModeShortFormat
+	}
+	return scoring.ParseScore(scoringMode, score)
+}
+
+// resultSets returns the sets of a result-only match as completed sets.
+// Short-format matches have no sets.
+func resultSets(match *model.Match) []model.SetScore {
+	sets := []model.SetScore{}
+	if match.Mode == model.MatchModeShort {
+		return sets
+	}
+	score, err := storedScore(match)
+	if err != nil {
+		return sets
+	}
+	for _, set := range score.Sets {
+		sets = append(sets, model.SetScore{GamesA: set.GamesA, GamesB: set.GamesB, TieBreak: set.TieBreak})
+	}
+	return sets
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/scoring"
+)
+
+func TestResultOnlySnapshot(t *testing.T) {
+	match, players := newTestMatch(model.MatchModeStandard)
+	winner := model.TeamB
+	match.Status = model.MatchStatusCompleted
+	match.ResultOnly = true
+	match.WinnerTeam = &winner
+	match.Score = "6-4 3-6 6-7"
+
+	replay, err := newMatchReplay(match, players)
+	if err != nil {
+		t.Fatalf("newMatchReplay: %v", err)
+	}
+	live := replay.snapshot()
+	if !live.Decided || live.Winner == nil || *live.Winner != model.TeamB {
+		t.Errorf("expected a decided match won by B, got %+v", live)
+	}
+	if len(live.Sets) != 3 || live.Sets[2] != (model.SetScore{GamesA: 6, GamesB: 7}) {
+		t.Errorf("expected the stored sets, got %v", live.Sets)
+	}
+	if sets := live.Display.Sets; sets == nil || sets.A != 1 || sets.B != 2 {
+		t.Errorf("expected sets won 1-2, got %+v", sets)
+	}
+
+	export, err := buildMatchExport(*match, players, nil, nil)
+	if err != nil {
+		t.Fatalf("buildMatchExport: %v", err)
+	}
+	if len(export.Sets) != 3 || export.PointsA != 0 || export.PointsB != 0 {
+		t.Errorf("expected 3 sets and no points, got %+v", export)
+	}
+}
+
+func TestResultSetsShortFormat(t *testing.T) {
+	match, _ := newTestMatch(model.MatchModeShort)
+	match.ResultOnly = true
+	match.Score = "2-1"
+
+	if sets := resultSets(match); len(sets) != 0 {
+		t.Errorf("expected no sets for short-format, got %v", sets)
+	}
+	score, err := storedScore(match)
+	if err != nil || score.Winner != scoring.TeamA {
+		t.Errorf("expected A to win, got %v %v", score, err)
+	}
+}
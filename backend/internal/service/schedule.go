@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+)
+
+// plannedDuration is how long a match is expected to occupy its players and
+// court when checking the schedule for double-bookings.
+func plannedDuration(mode model.MatchMode) time.Duration {
+	if mode == model.MatchModeShort {
+		return 30 * time.Minute
+	}
+	return 90 * time.Minute
+}
+
+// GetSchedule returns the matches planned or being played on a day, with
+// any player or court booked for overlapping matches. day is the start of
+// the day in the caller's time zone.
+func (s *MatchService) GetSchedule(ctx context.Context, day time.Time, venueID *uuid.UUID) (*model.Schedule, error) {
+	if venueID != nil {
+		if _, err := s.venueRepo.GetByID(ctx, *venueID); err != nil {
+			return nil, fmt.Errorf("venue not found: %w", err)
+		}
+	}
+
+	matches, err := s.matchRepo.ListSchedule(ctx, day, day.AddDate(0, 0, 1), venueID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(matches))
+	for i, m := range matches {
+		ids[i] = m.ID
+	}
+	players, err := s.matchRepo.GetPlayersForMatches(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	courts := make(map[uuid.UUID]*model.Court)
+	entries := make([]model.ScheduleEntry, 0, len(matches))
+	for _, m := range matches {
+		entry := model.ScheduleEntry{
+			Match:      m,
+			Players:    players[m.ID],
+			PlannedEnd: m.StartedAt.Add(plannedDuration(m.Mode)),
+		}
+		if entry.Players == nil {
+			entry.Players = []model.MatchPlayer{}
+		}
+
+		if m.CourtID != nil {
+			court, ok := courts[*m.CourtID]
+			if !ok {
+				court, err = s.courtRepo.GetByID(ctx, *m.CourtID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get court: %w", err)
+				}
+				courts[*m.CourtID] = court
+			}
+			entry.Court = court
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return &model.Schedule{
+		Date:      day.Format("2006-01-02"),
+		VenueID:   venueID,
+		Entries:   entries,
+		Conflicts: findScheduleConflicts(entries),
+	}, nil
+}
+
+// findScheduleConflicts reports every pair of overlapping matches that share
+// a player or a court.
+func findScheduleConflicts(entries []model.ScheduleEntry) []model.ScheduleConflict {
+	sorted := append([]model.ScheduleEntry(nil), entries...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Match.StartedAt.Before(sorted[j].Match.StartedAt)
+	})
+
+	conflicts := []model.ScheduleConflict{}
+	for i := range sorted {
+		a := sorted[i]
+		for j := i + 1; j < len(sorted); j++ {
+			b := sorted[j]
+
+			// Sorted by start, so no later match can overlap a either
+			if !b.Match.StartedAt.Before(a.PlannedEnd) {
+				break
+			}
+
+			pair := []uuid.UUID{a.Match.ID, b.Match.ID}
+
+			if a.Match.CourtID != nil && b.Match.CourtID != nil && *a.Match.CourtID == *b.Match.CourtID {
+				court := *a.Match.CourtID
+				conflicts = append(conflicts, model.ScheduleConflict{
+					Type:     model.ScheduleConflictCourt,
+					MatchIDs: pair,
+					CourtID:  &court,
+				})
+			}
+
+			for _, pa := range a.Players {
+				for _, pb := range b.Players {
+					if pa.PlayerID == pb.PlayerID {
+						player := pa.PlayerID
+						conflicts = append(conflicts, model.ScheduleConflict{
+							Type:     model.ScheduleConflictPlayer,
+							MatchIDs: pair,
+							PlayerID: &player,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return conflicts
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+)
+
+// scheduleEntry builds a schedule entry for the given players and court.
+func scheduleEntry(start time.Time, court *uuid.UUID, players ...uuid.UUID) model.ScheduleEntry {
+	match := model.Match{ID: uuid.New(), Mode: model.MatchModeStandard, CourtID: court, StartedAt: start}
+	entry := model.ScheduleEntry{Match: match, PlannedEnd: start.Add(plannedDuration(match.Mode))}
+	for _, p := range players {
+		entry.Players = append(entry.Players, model.MatchPlayer{MatchID: match.ID, PlayerID: p})
+	}
+	return entry
+}
+
+func TestFindScheduleConflicts(t *testing.T) {
+	start := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	court1, court2 := uuid.New(), uuid.New()
+	alice, bob, carol, dave := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	entries := []model.ScheduleEntry{
+		scheduleEntry(start, &court1, alice, bob),
+		// Same court an hour later, while the first match is still planned
+		scheduleEntry(start.Add(time.Hour), &court1, carol, dave),
+		// Alice again on another court, overlapping the first match
+		scheduleEntry(start.Add(30*time.Minute), &court2, alice, carol),
+		// After everything else has finished
+		scheduleEntry(start.Add(4*time.Hour), &court1, alice, bob),
+	}
+
+	conflicts := findScheduleConflicts(entries)
+
+	var courts, players int
+	for _, c := range conflicts {
+		switch c.Type {
+		case model.ScheduleConflictCourt:
+			courts++
+			if *c.CourtID != court1 {
+				t.Errorf("unexpected court conflict on %s", c.CourtID)
+			}
+		case model.ScheduleConflictPlayer:
+			players++
+		}
+	}
+
+	// court1 at 9:00 and 10:00; alice at 9:00 and 9:30; carol at 9:30 and 10:00
+	if courts != 1 || players != 2 {
+		t.Errorf("expected 1 court and 2 player conflicts, got %d and %d: %+v", courts, players, conflicts)
+	}
+}
@@ -0,0 +1,372 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+)
+
+// ScorecardFormat is the output format of a match scorecard.
+type ScorecardFormat string
+
+const (
+	ScorecardHTML ScorecardFormat = "html"
+	ScorecardSVG  ScorecardFormat = "svg"
+)
+
+// ErrInvalidScorecardFormat is returned for an unknown scorecard format.
+var ErrInvalidScorecardFormat = errors.New("format must be html or svg")
+
+// ParseScorecardFormat validates a scorecard format name.
+func ParseScorecardFormat(name string) (ScorecardFormat, error) {
+	switch f := ScorecardFormat(name); f {
+	case ScorecardHTML, ScorecardSVG:
+		return f, nil
+	}
+	return "", ErrInvalidScorecardFormat
+}
+
+// ContentType returns the MIME type of the format.
+func (f ScorecardFormat) ContentType() string {
+	if f == ScorecardSVG {
+		return "image/svg+xml; charset=utf-8"
+	}
+	return "text/html; charset=utf-8"
+}
+
+// ScorecardOptions controls how a scorecard is rendered.
+type ScorecardOptions struct {
+	Format ScorecardFormat
+
+	// Print renders a black-and-white variant sized for an A4 page
+	Print bool
+
+	// Location is the time zone the match date is shown in (default UTC)
+	Location *time.Location
+}
+
+// Scorecard renders a self-contained scorecard of a match: the players,
+// venue, date, set-by-set scoreline with tie-breaks and key serve stats.
+func (s *MatchService) Scorecard(ctx context.Context, matchID uuid.UUID, opts ScorecardOptions) ([]byte, error) {
+	summary, err := s.GetMatchSummary(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	return renderScorecard(summary, opts)
+}
+
+// Scorecard layout, in SVG user units
+const (
+	scorecardWidth     = 760
+	scorecardSetX      = 470 // Centre of the first set column
+	scorecardSetStep   = 80
+	scorecardRowHeight = 40
+	scorecardStatStep  = 26
+)
+
+// scorecardTheme holds the colours of a scorecard variant.
+type scorecardTheme struct {
+	Band, BandText, Text, Muted, Rule, Background string
+}
+
+var (
+	scorecardScreenTheme = scorecardTheme{Band: "#1b5e20", BandText: "#ffffff", Text: "#212121", Muted: "#616161", Rule: "#c8e6c9", Background: "#ffffff"}
+	scorecardPrintTheme  = scorecardTheme{Band: "#ffffff", BandText: "#000000", Text: "#000000", Muted: "#000000", Rule: "#000000", Background: "#ffffff"}
+)
+
+// scorecardView is the laid-out content of a scorecard.
+type scorecardView struct {
+	Title    string
+	Subtitle string
+	Print    bool
+	Theme    scorecardTheme
+	Width    int
+	Height   int
+	Right    int // End of the table rules
+
+	// Score table
+	Columns []scorecardText
+	Teams   []scorecardTeamRow
+	Status  scorecardText
+
+	// Key stats; empty for result-only matches
+	StatsY      int
+	StatHeaders []scorecardText
+	Stats       []scorecardStatRow
+	Totals      scorecardText
+}
+
+type scorecardText struct {
+	X, Y int
+	Text string
+}
+
+type scorecardTeamRow struct {
+	Y      int
+	RuleY  int
+	Names  string
+	Winner bool
+	Cells  []scorecardCell
+}
+
+type scorecardCell struct {
+	X, Y     int
+	Games    int
+	TieBreak string // Shown on the loser's side of a tie-break set
+	Won      bool
+}
+
+type scorecardStatRow struct {
+	Y     int
+	Cells []scorecardText
+}
+
+// renderScorecard lays out a match summary and renders it.
+func renderScorecard(summary *model.MatchSummary, opts ScorecardOptions) ([]byte, error) {
+	view := layoutScorecard(summary, opts)
+
+	var buf bytes.Buffer
+	name := "html"
+	if opts.Format == ScorecardSVG {
+		name = "card"
+		buf.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	}
+	if err := scorecardTemplates.ExecuteTemplate(&buf, name, view); err != nil {
+		return nil, fmt.Errorf("failed to render scorecard: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// layoutScorecard positions the parts of a scorecard.
+func layoutScorecard(summary *model.MatchSummary, opts ScorecardOptions) *scorecardView {
+	loc := opts.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	names := map[model.Team][]string{}
+	for _, ps := range summary.PlayerStats {
+		names[ps.Team] = append(names[ps.Team], ps.PlayerName)
+	}
+	teamA := strings.Join(names[model.TeamA], " / ")
+	teamB := strings.Join(names[model.TeamB], " / ")
+
+	view := &scorecardView{
+		Title: teamA + " vs " + teamB,
+		Subtitle: strings.Join([]string{
+			summary.Venue.Name,
+			summary.StartedAt.In(loc).Format("Mon 2 Jan 2006"),
+			matchTypeLabel(summary.MatchType),
+		}, " · "),
+		Print: opts.Print,
+		Theme: scorecardScreenTheme,
+		Width: scorecardWidth,
+		Right: scorecardWidth - 32,
+	}
+	if opts.Print {
+		view.Theme = scorecardPrintTheme
+	}
+
+	// Score table: one column per set, or the games of a short-format match
+	type column struct {
+		a, b     int
+		tieBreak *int
+	}
+	var columns []column
+	if summary.Mode == model.MatchModeShort {
+		view.Columns = append(view.Columns, scorecardText{X: scorecardSetX, Y: 116, Text: "Games"})
+		columns = append(columns, column{a: summary.GamesA, b: summary.GamesB})
+	} else {
+		for i, set := range summary.Sets {
+			view.Columns = append(view.Columns, scorecardText{
+				X: scorecardSetX + i*scorecardSetStep, Y: 116, Text: "Set " + strconv.Itoa(i+1),
+			})
+			columns = append(columns, column{a: set.GamesA, b: set.GamesB, tieBreak: set.TieBreak})
+		}
+	}
+
+	for i, team := range []model.Team{model.TeamA, model.TeamB} {
+		row := scorecardTeamRow{
+			Y:      152 + i*scorecardRowHeight,
+			RuleY:  152 + i*scorecardRowHeight - 26,
+			Names:  teamA,
+			Winner: summary.WinnerTeam != nil && *summary.WinnerTeam == team,
+		}
+		if team == model.TeamB {
+			row.Names = teamB
+		}
+		for j, c := range columns {
+			cell := scorecardCell{X: scorecardSetX + j*scorecardSetStep, Y: row.Y, Games: c.a, Won: c.a > c.b}
+			if team == model.TeamB {
+				cell.Games, cell.Won = c.b, c.b > c.a
+			}
+			if c.tieBreak != nil && !cell.Won {
+				cell.TieBreak = strconv.Itoa(*c.tieBreak)
+			}
+			row.Cells = append(row.Cells, cell)
+		}
+		view.Teams = append(view.Teams, row)
+	}
+
+	y := 152 + 2*scorecardRowHeight
+	view.Status = scorecardText{X: 32, Y: y, Text: scorecardStatus(summary)}
+	y += 24
+
+	if summary.ResultOnly || len(summary.PlayerStats) == 0 {
+		view.Height = y + 8
+		return view
+	}
+
+	// Key stats, one row per player
+	y += 24
+	view.StatsY = y
+	y += 28
+	for i, label := range []string{"Player", "1st serve in", "1st serve won", "2nd serve won", "Double faults"} {
+		x := 32
+		if i > 0 {
+			x = 320 + (i-1)*110
+		}
+		view.StatHeaders = append(view.StatHeaders, scorecardText{X: x, Y: y, Text: label})
+	}
+	for _, ps := range summary.PlayerStats {
+		y += scorecardStatStep
+		values := []string{
+			ps.PlayerName,
+			percentText(ps.FirstServesIn, ps.FirstServesTotal),
+			percentText(ps.FirstServeWon, ps.FirstServesIn),
+			percentText(ps.SecondServeWon, ps.SecondServesIn),
+			strconv.Itoa(ps.DoubleFaults),
+		}
+		row := scorecardStatRow{Y: y}
+		for i, v := range values {
+			x := 32
+			if i > 0 {
+				x = 320 + (i-1)*110
+			}
+			row.Cells = append(row.Cells, scorecardText{X: x, Y: y, Text: v})
+		}
+		view.Stats = append(view.Stats, row)
+	}
+
+	y += 36
+	view.Totals = scorecardText{X: 32, Y: y, Text: fmt.Sprintf("Total points won: %s %d – %d %s",
+		teamA, summary.TeamAScore, summary.TeamBScore, teamB)}
+	view.Height = y + 24
+	return view
+}
+
+// scorecardStatus describes how the match ended.
+func scorecardStatus(summary *model.MatchSummary) string {
+	var parts []string
+	switch summary.Status {
+	case model.MatchStatusCompleted:
+		parts = append(parts, "Completed")
+	case model.MatchStatusAbandoned:
+		parts = append(parts, "Abandoned")
+	case model.MatchStatusSuspended:
+		parts = append(parts, "Suspended")
+	default:
+		parts = append(parts, "In progress")
+	}
+	if summary.ResultOnly {
+		parts = append(parts, "result only, no point-by-point stats")
+	} else if summary.PlayingSeconds > 0 {
+		d := time.Duration(summary.PlayingSeconds) * time.Second
+		parts = append(parts, fmt.Sprintf("%dh %02dm", int(d.Hours()), int(d.Minutes())%60))
+	}
+	return strings.Join(parts, " · ")
+}
+
+// matchTypeLabel names a match type for display.
+func matchTypeLabel(t model.MatchType) string {
+	switch t {
+	case model.MatchTypeSingles:
+		return "Singles"
+	case model.MatchTypeDoubles:
+		return "Doubles"
+	}
+	return "1 v 2"
+}
+
+// percentText formats part of whole as a whole-number percentage.
+func percentText(part, whole int) string {
+	if whole == 0 {
+		return "–"
+	}
+	return fmt.Sprintf("%d%% (%d/%d)", (part*100+whole/2)/whole, part, whole)
+}
+
+var scorecardTemplates = template.Must(template.New("scorecard").Parse(`
+{{- define "card" -}}
+<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 {{.Width}} {{.Height}}" {{if .Print}}width="100%"{{else}}width="{{.Width}}" height="{{.Height}}"{{end}} font-family="Helvetica, Arial, sans-serif">
+<title>{{.Title}}</title>
+<rect width="{{.Width}}" height="{{.Height}}" fill="{{.Theme.Background}}"/>
+<rect width="{{.Width}}" height="84" fill="{{.Theme.Band}}"/>
+{{- if .Print}}
+<line x1="0" y1="84" x2="{{.Width}}" y2="84" stroke="{{.Theme.Rule}}" stroke-width="2"/>
+{{- end}}
+<text x="32" y="38" font-size="22" font-weight="bold" fill="{{.Theme.BandText}}">{{.Title}}</text>
+<text x="32" y="66" font-size="14" fill="{{.Theme.BandText}}">{{.Subtitle}}</text>
+{{- range .Columns}}
+<text x="{{.X}}" y="{{.Y}}" font-size="13" text-anchor="middle" fill="{{$.Theme.Muted}}">{{.Text}}</text>
+{{- end}}
+{{- range .Teams}}
+<line x1="32" y1="{{.RuleY}}" x2="{{$.Right}}" y2="{{.RuleY}}" stroke="{{$.Theme.Rule}}"/>
+<text x="32" y="{{.Y}}" font-size="18" fill="{{$.Theme.Text}}"{{if .Winner}} font-weight="bold"{{end}}>{{.Names}}{{if .Winner}} ✓{{end}}</text>
+{{- range .Cells}}
+<text x="{{.X}}" y="{{.Y}}" font-size="20" text-anchor="middle" fill="{{$.Theme.Text}}"{{if .Won}} font-weight="bold"{{end}}>{{.Games}}{{if .TieBreak}}<tspan dy="-8" font-size="12">{{.TieBreak}}</tspan>{{end}}</text>
+{{- end}}
+{{- end}}
+<text x="{{.Status.X}}" y="{{.Status.Y}}" font-size="13" fill="{{.Theme.Muted}}">{{.Status.Text}}</text>
+{{- if .Stats}}
+<text x="32" y="{{.StatsY}}" font-size="16" font-weight="bold" fill="{{.Theme.Text}}">Key stats</text>
+{{- range .StatHeaders}}
+<text x="{{.X}}" y="{{.Y}}" font-size="12" fill="{{$.Theme.Muted}}">{{.Text}}</text>
+{{- end}}
+{{- range .Stats}}
+{{- range .Cells}}
+<text x="{{.X}}" y="{{.Y}}" font-size="14" fill="{{$.Theme.Text}}">{{.Text}}</text>
+{{- end}}
+{{- end}}
+<text x="{{.Totals.X}}" y="{{.Totals.Y}}" font-size="14" fill="{{.Theme.Text}}">{{.Totals.Text}}</text>
+{{- end}}
+</svg>
+{{end}}
+
+{{- define "html" -}}
+<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { margin: 0; background: #eceff1; }
+main { max-width: {{.Width}}px; margin: 24px auto; background: #fff; box-shadow: 0 1px 4px rgba(0, 0, 0, 0.2); }
+svg { display: block; }
+@page { size: A4 landscape; margin: 12mm; }
+@media print {
+  body { background: #fff; }
+  main { max-width: none; margin: 0; box-shadow: none; }
+}
+{{- if .Print}}
+body { background: #fff; }
+main { max-width: none; margin: 0; box-shadow: none; }
+{{- end}}
+</style>
+</head>
+<body>
+<main>
+{{template "card" .}}
+</main>
+</body>
+</html>
+{{end}}
+`))
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+)
+
+func testScorecardSummary() *model.MatchSummary {
+	tieBreak := 5
+	winner := model.TeamA
+	return &model.MatchSummary{
+		Venue:      model.Venue{Name: "Riverside <North>"},
+		MatchType:  model.MatchTypeSingles,
+		Mode:       model.MatchModeStandard,
+		Status:     model.MatchStatusCompleted,
+		StartedAt:  time.Date(2024, 6, 1, 20, 0, 0, 0, time.UTC),
+		WinnerTeam: &winner,
+		Sets: []model.SetScore{
+			{GamesA: 6, GamesB: 4},
+			{GamesA: 7, GamesB: 6, TieBreak: &tieBreak},
+		},
+		PlayerStats: []model.PlayerMatchStats{
+			{PlayerID: uuid.New(), PlayerName: "Asha", Team: model.TeamA, FirstServesIn: 30, FirstServesTotal: 50},
+			{PlayerID: uuid.New(), PlayerName: "Ben & Cat", Team: model.TeamB},
+		},
+	}
+}
+
+func TestRenderScorecard(t *testing.T) {
+	card, err := renderScorecard(testScorecardSummary(), ScorecardOptions{Format: ScorecardSVG})
+	if err != nil {
+		t.Fatalf("renderScorecard: %v", err)
+	}
+	svg := string(card)
+
+	for _, want := range []string{
+		`<?xml version="1.0"`,
+		"Asha vs Ben &amp; Cat",    // Names are escaped
+		"Riverside &lt;North&gt;",  // So is the venue
+		"Sat 1 Jun 2024",           // Date in UTC by default
+		`font-size="12">5</tspan>`, // Tie-break points on the loser's 6
+		"60% (30/50)",              // First serve in
+		"Key stats",
+	} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("expected scorecard to contain %q", want)
+		}
+	}
+
+	// Dates follow the requested time zone
+	loc := time.FixedZone("UTC+5", 5*60*60)
+	card, _ = renderScorecard(testScorecardSummary(), ScorecardOptions{Format: ScorecardSVG, Location: loc})
+	if !strings.Contains(string(card), "Sun 2 Jun 2024") {
+		t.Error("expected the date in the requested time zone")
+	}
+}
+
+func TestRenderScorecardPrintHTML(t *testing.T) {
+	card, err := renderScorecard(testScorecardSummary(), ScorecardOptions{Format: ScorecardHTML, Print: true})
+	if err != nil {
+		t.Fatalf("renderScorecard: %v", err)
+	}
+	html := string(card)
+
+	if !strings.HasPrefix(html, "<!DOCTYPE html>") || !strings.Contains(html, "<svg") {
+		t.Error("expected an HTML page with the scorecard inline")
+	}
+	if strings.Contains(html, scorecardScreenTheme.Band) {
+		t.Error("expected the print variant to use no band colour")
+	}
+}
+
+func TestRenderScorecardResultOnly(t *testing.T) {
+	summary := testScorecardSummary()
+	summary.ResultOnly = true
+
+	card, err := renderScorecard(summary, ScorecardOptions{Format: ScorecardSVG})
+	if err != nil {
+		t.Fatalf("renderScorecard: %v", err)
+	}
+	if strings.Contains(string(card), "Key stats") {
+		t.Error("expected no stats for a result-only match")
+	}
+	if !strings.Contains(string(card), "result only") {
+		t.Error("expected the scorecard to say the match is result only")
+	}
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+)
+
+// sequenceEvents checks incoming events against the stored ones and returns
+// the events that still need to be inserted.
+//
+// Scoring devices number their points from 1. Events without a sequence
+// number (older clients) are numbered after the highest known sequence, in
+// the order they were sent. Retries of stored events are skipped; a sequence
+// number already held by another event is rejected.
+func sequenceEvents(stored, incoming []model.PointEvent) ([]model.PointEvent, error) {
+	storedIDs := make(map[uuid.UUID]bool, len(stored))
+	owners := make(map[int]uuid.UUID, len(stored)+len(incoming))
+	last := 0
+	for _, e := range stored {
+		storedIDs[e.ID] = true
+		owners[e.Seq] = e.ID
+		if e.Seq > last {
+			last = e.Seq
+		}
+	}
+	for _, e := range incoming {
+		if e.Seq > last {
+			last = e.Seq
+		}
+	}
+
+	pending := make([]model.PointEvent, 0, len(incoming))
+	queued := make(map[uuid.UUID]bool, len(incoming))
+	for _, e := range incoming {
+		if e.Seq < 0 {
+			return nil, fmt.Errorf("invalid sequence number %d", e.Seq)
+		}
+		if storedIDs[e.ID] || queued[e.ID] {
+			continue
+		}
+
+		if e.Seq == 0 {
+			last++
+			e.Seq = last
+		}
+
+		if owner, ok := owners[e.Seq]; ok && owner != e.ID {
+			return nil, fmt.Errorf("%w: %d", repository.ErrSequenceTaken, e.Seq)
+		}
+		owners[e.Seq] = e.ID
+		queued[e.ID] = true
+		pending = append(pending, e)
+	}
+
+	return pending, nil
+}
+
+// missingSequences returns the highest sequence number and every gap below it.
+func missingSequences(seqs []int) (int, []model.SeqRange) {
+	sorted := append([]int(nil), seqs...)
+	sort.Ints(sorted)
+
+	missing := []model.SeqRange{}
+	expected := 1
+	for _, seq := range sorted {
+		if seq > expected {
+			missing = append(missing, model.SeqRange{From: expected, To: seq - 1})
+		}
+		if seq >= expected {
+			expected = seq + 1
+		}
+	}
+	return expected - 1, missing
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+)
+
+func TestSequenceEventsSkipsRetriesAndRejectsTakenSeq(t *testing.T) {
+	matchID, server := uuid.New(), uuid.New()
+	stored := pointEvents(matchID, server, time.Now(), "AB")
+
+	incoming := pointEvents(matchID, server, time.Now(), "AAA")
+	incoming[0] = stored[1] // retry of seq 2
+	incoming[1].Seq = 3
+	incoming[2].Seq = 5
+
+	pending, err := sequenceEvents(stored, incoming)
+	if err != nil {
+		t.Fatalf("sequenceEvents: %v", err)
+	}
+	if len(pending) != 2 || pending[0].Seq != 3 || pending[1].Seq != 5 {
+		t.Errorf("expected seqs 3 and 5 to be inserted, got %+v", pending)
+	}
+
+	taken := pointEvents(matchID, server, time.Now(), "A")
+	taken[0].Seq = 2
+	if _, err := sequenceEvents(stored, taken); !errors.Is(err, repository.ErrSequenceTaken) {
+		t.Errorf("expected ErrSequenceTaken, got %v", err)
+	}
+}
+
+func TestSequenceEventsNumbersLegacyEvents(t *testing.T) {
+	matchID, server := uuid.New(), uuid.New()
+	stored := pointEvents(matchID, server, time.Now(), "AB")
+
+	incoming := pointEvents(matchID, server, time.Now(), "AA")
+	for i := range incoming {
+		incoming[i].Seq = 0
+	}
+
+	pending, err := sequenceEvents(stored, incoming)
+	if err != nil {
+		t.Fatalf("sequenceEvents: %v", err)
+	}
+	if pending[0].Seq != 3 || pending[1].Seq != 4 {
+		t.Errorf("expected unnumbered events to follow the stored ones, got %d and %d", pending[0].Seq, pending[1].Seq)
+	}
+}
+
+func TestMissingSequences(t *testing.T) {
+	last, missing := missingSequences([]int{5, 1, 2, 8})
+	if last != 8 {
+		t.Errorf("expected last seq 8, got %d", last)
+	}
+	want := []model.SeqRange{{From: 3, To: 4}, {From: 6, To: 7}}
+	if len(missing) != len(want) || missing[0] != want[0] || missing[1] != want[1] {
+		t.Errorf("expected %+v, got %+v", want, missing)
+	}
+
+	if last, missing := missingSequences(nil); last != 0 || len(missing) != 0 {
+		t.Errorf("expected no gaps for an empty match, got %d %+v", last, missing)
+	}
+}
@@ -0,0 +1,381 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+)
+
+// ErrSessionAhead is returned when a device claims to have seen more points
+// than the server has recorded. The device should poll and resubmit.
+var ErrSessionAhead = errors.New("device is ahead of the recorded match")
+
+// ErrConflictResolved is returned when resolving a conflict twice.
+var ErrConflictResolved = errors.New("conflict already resolved")
+
+const (
+	// sessionIdle is how long a session is kept without submissions or
+	// polls. Devices that come back later start a fresh session; their
+	// retried points are still recognised by event ID.
+	sessionIdle = 6 * time.Hour
+
+	// sessionSweepInterval is how often idle sessions are looked for.
+	sessionSweepInterval = 10 * time.Minute
+)
+
+// SessionPoint is a point submitted by a scoring device.
+type SessionPoint struct {
+	// ClientSeq increases with every point a device submits. Points at or
+	// below the device's last processed sequence are treated as retries.
+	ClientSeq int
+	Event     model.PointEvent
+}
+
+// SessionService coordinates several devices scoring the same match.
+//
+// Each submission says how many points the device had seen (basePoints).
+// Points are lined up against the recorded history from that position:
+//   - a point past the end of the history is appended
+//   - a point at a recorded position with the same winner is the same rally
+//     recorded twice and is merged
+//   - a point with a different winner is a divergence: it is stored as a
+//     conflict for a human to resolve and the rest of the batch is dropped
+type SessionService struct {
+	matches   *MatchService
+	matchRepo *repository.MatchRepository
+
+	mu        sync.Mutex
+	sessions  map[uuid.UUID]*scoringSession
+	lastSweep time.Time
+}
+
+// scoringSession is the in-memory coordination state for a match.
+type scoringSession struct {
+	// mu serialises submissions so devices are reconciled one at a time
+	mu      sync.Mutex
+	devices map[string]*model.SessionDevice
+
+	// lastUsed is guarded by SessionService.mu
+	lastUsed time.Time
+}
+
+// NewSessionService creates a new scoring session service.
+func NewSessionService(matches *MatchService, matchRepo *repository.MatchRepository) *SessionService {
+	return &SessionService{
+		matches:   matches,
+		matchRepo: matchRepo,
+		sessions:  make(map[uuid.UUID]*scoringSession),
+		lastSweep: time.Now(),
+	}
+}
+
+// Submit reconciles points from a device with the recorded match.
+func (s *SessionService) Submit(ctx context.Context, matchID uuid.UUID, deviceID string, basePoints int, points []SessionPoint) (*model.SessionSubmitResult, error) {
+	sess := s.session(matchID)
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+
+	device := sess.device(deviceID)
+	result := &model.SessionSubmitResult{}
+
+	// Drop retries of points this device already submitted
+	fresh := make([]SessionPoint, 0, len(points))
+	for _, p := range points {
+		if p.ClientSeq <= device.LastClientSeq {
+			result.Duplicates++
+			continue
+		}
+		fresh = append(fresh, p)
+	}
+	sort.SliceStable(fresh, func(i, j int) bool { return fresh[i].ClientSeq < fresh[j].ClientSeq })
+
+	canonical, err := s.matchRepo.GetEvents(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+
+	submitted := make([]model.PointEvent, len(fresh))
+	for i, p := range fresh {
+		submitted[i] = p.Event
+		submitted[i].MatchID = matchID
+	}
+
+	rec, err := reconcilePoints(canonical, basePoints, submitted)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rec.appended) > 0 {
+		added, err := s.matches.AddEvents(ctx, matchID, rec.appended)
+		if err != nil {
+			return nil, err
+		}
+		result.Accepted = added.Inserted
+	}
+	result.Merged = rec.merged
+	result.Duplicates += rec.duplicates
+
+	// Only points before a conflict count as processed
+	if rec.processed > 0 {
+		device.LastClientSeq = fresh[rec.processed-1].ClientSeq
+	}
+	device.LastSeenAt = time.Now()
+
+	if rec.conflict != nil {
+		// A retried batch brings the same point back; report the open conflict
+		conflict, err := s.matchRepo.GetOpenConflictByProposed(ctx, matchID, rec.conflict.proposed.ID)
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			conflict = &model.PointConflict{
+				MatchID:          matchID,
+				DeviceID:         deviceID,
+				Position:         rec.conflict.position,
+				CanonicalEventID: rec.conflict.canonical.ID,
+				Proposed:         rec.conflict.proposed,
+			}
+			if err := s.matchRepo.CreateConflict(ctx, conflict); err != nil {
+				return nil, err
+			}
+			s.notify(ctx, matchID)
+		case err != nil:
+			return nil, err
+		}
+		result.Conflict = conflict
+	}
+
+	result.Session, err = s.snapshot(ctx, matchID, sess.deviceList())
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Poll returns the session once something has changed after cursor, or
+// after timeout. A zero cursor returns immediately.
+func (s *SessionService) Poll(ctx context.Context, matchID uuid.UUID, cursor uint64, timeout time.Duration) (*model.ScoringSession, error) {
+	if _, err := s.matchRepo.GetByID(ctx, matchID); err != nil {
+		return nil, fmt.Errorf("match not found: %w", err)
+	}
+
+	if cursor > 0 {
+		sub, missed, resumed := s.matches.hub.subscribeMatch(matchID, cursor)
+		defer s.matches.hub.unsubscribe(sub)
+
+		if resumed && len(missed) == 0 {
+			timer := time.NewTimer(timeout)
+			defer timer.Stop()
+
+			select {
+			case <-sub.C:
+			case <-timer.C:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	sess := s.session(matchID)
+	sess.mu.Lock()
+	devices := sess.deviceList()
+	sess.mu.Unlock()
+
+	return s.snapshot(ctx, matchID, devices)
+}
+
+// Resolve settles a conflict. Accepting the proposed point replaces the
+// outcome of the canonical point at the same position.
+func (s *SessionService) Resolve(ctx context.Context, matchID, conflictID uuid.UUID, resolution model.ConflictResolution) (*model.ScoringSession, error) {
+	sess := s.session(matchID)
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+
+	conflict, err := s.matchRepo.GetConflict(ctx, matchID, conflictID)
+	if err != nil {
+		return nil, fmt.Errorf("conflict not found: %w", err)
+	}
+	if conflict.ResolvedAt != nil {
+		return nil, ErrConflictResolved
+	}
+
+	switch resolution {
+	case model.ResolutionKeepCanonical:
+	case model.ResolutionAcceptProposed:
+		replacement := conflict.Proposed
+		replacement.ID = conflict.CanonicalEventID
+		replacement.MatchID = matchID
+		if err := s.matches.CorrectEvent(ctx, replacement); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("invalid resolution")
+	}
+
+	if err := s.matchRepo.ResolveConflict(ctx, conflictID, resolution, time.Now()); err != nil {
+		return nil, err
+	}
+	s.notify(ctx, matchID)
+
+	return s.snapshot(ctx, matchID, sess.deviceList())
+}
+
+// snapshot builds the session view shared with all devices.
+func (s *SessionService) snapshot(ctx context.Context, matchID uuid.UUID, devices []model.SessionDevice) (*model.ScoringSession, error) {
+	cursor := s.matches.hub.cursor()
+
+	state, err := s.matches.GetLiveState(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts, err := s.matchRepo.ListOpenConflicts(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ScoringSession{
+		MatchID:   matchID,
+		Cursor:    cursor,
+		State:     state,
+		Conflicts: conflicts,
+		Devices:   devices,
+	}, nil
+}
+
+// notify wakes pollers for changes that don't alter the score.
+func (s *SessionService) notify(ctx context.Context, matchID uuid.UUID) {
+	state, err := s.matches.GetLiveState(ctx, matchID)
+	if err != nil {
+		return
+	}
+	s.matches.hub.publish(model.LiveUpdateConflict, state)
+}
+
+// session returns the coordination state for a match, creating it on demand.
+// Sessions left idle for sessionIdle are dropped.
+func (s *SessionService) session(matchID uuid.UUID) *scoringSession {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.lastSweep) >= sessionSweepInterval {
+		s.lastSweep = now
+		for id, sess := range s.sessions {
+			if now.Sub(sess.lastUsed) > sessionIdle {
+				delete(s.sessions, id)
+			}
+		}
+	}
+
+	sess, ok := s.sessions[matchID]
+	if !ok {
+		sess = &scoringSession{devices: make(map[string]*model.SessionDevice)}
+		s.sessions[matchID] = sess
+	}
+	sess.lastUsed = now
+	return sess
+}
+
+// deviceList returns a copy of the session's devices, ordered by ID.
+// Caller holds sess.mu.
+func (sess *scoringSession) deviceList() []model.SessionDevice {
+	devices := make([]model.SessionDevice, 0, len(sess.devices))
+	for _, d := range sess.devices {
+		devices = append(devices, *d)
+	}
+	sort.Slice(devices, func(i, j int) bool { return devices[i].DeviceID < devices[j].DeviceID })
+	return devices
+}
+
+// device returns a device's state, registering it on first contact.
+// Caller holds sess.mu.
+func (sess *scoringSession) device(deviceID string) *model.SessionDevice {
+	d, ok := sess.devices[deviceID]
+	if !ok {
+		d = &model.SessionDevice{DeviceID: deviceID}
+		sess.devices[deviceID] = d
+	}
+	return d
+}
+
+// ─────────────────────────────────────────────────────────────────────────────
+// RECONCILIATION
+// ─────────────────────────────────────────────────────────────────────────────
+
+// reconciliation is the outcome of lining submitted points up with history.
+type reconciliation struct {
+	appended   []model.PointEvent
+	merged     int
+	duplicates int
+
+	// processed is how many submitted points were handled before a conflict
+	processed int
+
+	conflict *divergence
+}
+
+// divergence is a point where two devices recorded different winners.
+type divergence struct {
+	position  int
+	canonical model.PointEvent
+	proposed  model.PointEvent
+}
+
+// reconcilePoints lines submitted points up against the canonical history,
+// starting at position base. It is deterministic: the same history and
+// submission always produce the same result.
+//
+// Appended points are left unnumbered so AddEvents numbers them after the
+// last sequence number in use; the device's own numbering is not used
+// because devices may disagree.
+func reconcilePoints(canonical []model.PointEvent, base int, submitted []model.PointEvent) (reconciliation, error) {
+	var rec reconciliation
+
+	if base < 0 || base > len(canonical) {
+		return rec, ErrSessionAhead
+	}
+
+	positions := make(map[uuid.UUID]int, len(canonical))
+	for i, e := range canonical {
+		positions[e.ID] = i
+	}
+
+	pos := base
+	for _, event := range submitted {
+		// Already stored (e.g. a retry after a lost response)
+		if i, ok := positions[event.ID]; ok {
+			rec.duplicates++
+			rec.processed++
+			pos = i + 1
+			continue
+		}
+
+		if pos < len(canonical) {
+			recorded := canonical[pos]
+			if recorded.PointWinnerTeam != event.PointWinnerTeam {
+				rec.conflict = &divergence{position: pos, canonical: recorded, proposed: event}
+				return rec, nil
+			}
+
+			// Same rally recorded by another device; the first record is kept
+			rec.merged++
+			rec.processed++
+			pos++
+			continue
+		}
+
+		event.Seq = 0
+
+		rec.appended = append(rec.appended, event)
+		rec.processed++
+		pos++
+	}
+
+	return rec, nil
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestReconcilePointsAppendsAtHead(t *testing.T) {
+	matchID, server := uuid.New(), uuid.New()
+	start := time.Now()
+	canonical := pointEvents(matchID, server, start, "AB")
+	submitted := pointEvents(matchID, server, start.Add(time.Minute), "AA")
+
+	rec, err := reconcilePoints(canonical, 2, submitted)
+	if err != nil {
+		t.Fatalf("reconcilePoints: %v", err)
+	}
+	if len(rec.appended) != 2 || rec.conflict != nil || rec.processed != 2 {
+		t.Errorf("expected 2 appended points, got %+v", rec)
+	}
+}
+
+func TestReconcilePointsMergesSameRally(t *testing.T) {
+	matchID, server := uuid.New(), uuid.New()
+	start := time.Now()
+	canonical := pointEvents(matchID, server, start, "ABA")
+
+	// Second device saw one point, then recorded the same two rallies plus a new one
+	submitted := pointEvents(matchID, server, start, "BAB")
+
+	rec, err := reconcilePoints(canonical, 1, submitted)
+	if err != nil {
+		t.Fatalf("reconcilePoints: %v", err)
+	}
+	if rec.merged != 2 || len(rec.appended) != 1 {
+		t.Errorf("expected 2 merged and 1 appended, got %+v", rec)
+	}
+
+	// Appended points are numbered by AddEvents, after every number in use
+	if rec.appended[0].Seq != 0 {
+		t.Errorf("expected appended point to be left for AddEvents to number, got seq %d", rec.appended[0].Seq)
+	}
+}
+
+func TestReconcilePointsFlagsDivergence(t *testing.T) {
+	matchID, server := uuid.New(), uuid.New()
+	start := time.Now()
+	canonical := pointEvents(matchID, server, start, "AA")
+	submitted := pointEvents(matchID, server, start, "ABA")
+
+	rec, err := reconcilePoints(canonical, 0, submitted)
+	if err != nil {
+		t.Fatalf("reconcilePoints: %v", err)
+	}
+	if rec.conflict == nil || rec.conflict.position != 1 {
+		t.Fatalf("expected conflict at position 1, got %+v", rec.conflict)
+	}
+	if rec.conflict.canonical.ID != canonical[1].ID {
+		t.Error("expected conflict to reference the recorded point")
+	}
+	if rec.processed != 1 || len(rec.appended) != 0 {
+		t.Errorf("expected points after the conflict to be dropped, got %+v", rec)
+	}
+}
+
+func TestReconcilePointsDuplicatesAndAhead(t *testing.T) {
+	matchID, server := uuid.New(), uuid.New()
+	canonical := pointEvents(matchID, server, time.Now(), "AB")
+
+	rec, err := reconcilePoints(canonical, 0, canonical)
+	if err != nil {
+		t.Fatalf("reconcilePoints: %v", err)
+	}
+	if rec.duplicates != 2 || len(rec.appended) != 0 {
+		t.Errorf("expected retries to be duplicates, got %+v", rec)
+	}
+
+	if _, err := reconcilePoints(canonical, 3, nil); err != ErrSessionAhead {
+		t.Errorf("expected ErrSessionAhead, got %v", err)
+	}
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/scoring"
+)
+
+// matchStats holds the statistics of a match derived from its points.
+type matchStats struct {
+	// players are in the order given to computeMatchStats
+	players []model.PlayerMatchStats
+
+	// teams holds a block for each side with more than one player
+	teams []model.TeamMatchStats
+
+	pointsA, pointsB int
+	gamesA, gamesB   int // Games won over the whole match
+
+	// replay is the match after every point, for the sets and final state
+	replay *matchReplay
+}
+
+// statsGame is a game being tallied: who served it and the points played.
+type statsGame struct {
+	set      int
+	server   uuid.UUID
+	tieBreak bool
+	deuce    bool
+	points   map[model.Team]int
+}
+
+// computeMatchStats replays a match's points through the scoring engine and
+// tallies per-player and per-side statistics. Serve counters and points use
+// every event; game-level stats stop once the engine has decided the match.
+func computeMatchStats(match *model.Match, players []model.MatchPlayer, names map[uuid.UUID]string, events []model.PointEvent) (*matchStats, error) {
+	replay, err := newMatchReplay(match, players)
+	if err != nil {
+		return nil, err
+	}
+
+	teamOf := make(map[uuid.UUID]model.Team)
+	teamSize := make(map[model.Team]int)
+	stats := make(map[uuid.UUID]*model.PlayerMatchStats)
+	for _, mp := range players {
+		teamOf[mp.PlayerID] = mp.Team
+		teamSize[mp.Team]++
+		stats[mp.PlayerID] = &model.PlayerMatchStats{
+			PlayerID:   mp.PlayerID,
+			PlayerName: names[mp.PlayerID],
+			Team:       mp.Team,
+		}
+	}
+	sides := map[model.Team]*model.TeamMatchStats{
+		model.TeamA: {Team: model.TeamA, PlayerIDs: []uuid.UUID{}},
+		model.TeamB: {Team: model.TeamB, PlayerIDs: []uuid.UUID{}},
+	}
+	for _, mp := range players {
+		sides[mp.Team].PlayerIDs = append(sides[mp.Team].PlayerIDs, mp.PlayerID)
+	}
+
+	result := &matchStats{replay: replay}
+	var games []*statsGame
+	var game *statsGame
+	var streakTeam model.Team
+	streak := 0
+
+	for _, event := range events {
+		winner := event.PointWinnerTeam
+		serverStats := stats[event.ServerPlayerID]
+		serverTeam, serverKnown := teamOf[event.ServerPlayerID]
+
+		// Serve counters
+		if serverStats != nil {
+			switch event.ServeType {
+			case model.ServeTypeFirst:
+				serverStats.FirstServesTotal++
+				serverStats.FirstServesIn++
+				if serverTeam == winner {
+					serverStats.FirstServeWon++
+				}
+			case model.ServeTypeSecond:
+				serverStats.FirstServesTotal++ // First serve was out
+				serverStats.SecondServesTotal++
+				serverStats.SecondServesIn++
+				if serverTeam == winner {
+					serverStats.SecondServeWon++
+				}
+			case model.ServeTypeDoubleFault:
+				serverStats.FirstServesTotal++
+				serverStats.SecondServesTotal++
+				serverStats.DoubleFaults++
+			}
+		}
+
+		if winner == model.TeamA {
+			result.pointsA++
+		} else {
+			result.pointsB++
+		}
+		sides[winner].PointsWon++
+
+		// Point streaks
+		if winner == streakTeam {
+			streak++
+		} else {
+			streakTeam, streak = winner, 1
+		}
+		if streak > sides[winner].LongestPointStreak {
+			sides[winner].LongestPointStreak = streak
+		}
+
+		// Serve and return points
+		if serverKnown {
+			receiver := otherTeam(serverTeam)
+			sides[receiver].ReturnPointsPlayed++
+			if winner == receiver {
+				sides[receiver].ReturnPointsWon++
+			} else {
+				serverStats.ServicePointsWon++
+				sides[serverTeam].ServicePointsWon++
+			}
+		}
+
+		// Game-level stats follow the scoring engine
+		prev := replay.state
+		if prev.Completed {
+			continue
+		}
+		replay.apply(event)
+		next := replay.state
+		if next == prev {
+			continue
+		}
+
+		if game == nil {
+			game = &statsGame{
+				set:      prev.CurrentSet,
+				server:   event.ServerPlayerID,
+				tieBreak: scoring.InTieBreak(prev),
+				points:   make(map[model.Team]int),
+			}
+			games = append(games, game)
+		}
+
+		// Break points: the receiver wins the game by winning this point
+		if serverKnown && !game.tieBreak {
+			receiver := otherTeam(serverTeam)
+			if game.points[receiver] >= 3 && game.points[receiver] > game.points[serverTeam] {
+				serverStats.BreakPointsFaced++
+				sides[serverTeam].BreakPointsFaced++
+				sides[receiver].BreakPointChances++
+				if winner == receiver {
+					sides[receiver].BreakPointsConverted++
+				} else {
+					serverStats.BreakPointsSaved++
+					sides[serverTeam].BreakPointsSaved++
+				}
+			}
+		}
+
+		game.points[winner]++
+		if !game.tieBreak && game.points[model.TeamA] >= 3 && game.points[model.TeamB] >= 3 {
+			game.deuce = true
+		}
+
+		if !gameEnded(prev, next) {
+			continue
+		}
+
+		// The point winner won the game
+		if winner == model.TeamA {
+			result.gamesA++
+		} else {
+			result.gamesB++
+		}
+		if game.deuce {
+			sides[model.TeamA].DeuceGamesPlayed++
+			sides[model.TeamB].DeuceGamesPlayed++
+			sides[winner].DeuceGamesWon++
+		}
+		if gameServer, ok := stats[game.server]; ok && !game.tieBreak {
+			gameServer.ServiceGamesPlayed++
+			sides[gameServer.Team].ServiceGamesPlayed++
+			if gameServer.Team == winner {
+				gameServer.ServiceGamesHeld++
+				sides[gameServer.Team].ServiceGamesHeld++
+			}
+		}
+		game = nil
+	}
+
+	// Points in the closing 4 games of every set
+	for i, g := range games {
+		if i+4 < len(games) && games[i+4].set == g.set {
+			continue
+		}
+		for _, team := range []model.Team{model.TeamA, model.TeamB} {
+			sides[team].Last4PointsPlayed += g.points[model.TeamA] + g.points[model.TeamB]
+			sides[team].Last4PointsWon += g.points[team]
+		}
+	}
+
+	for _, mp := range players {
+		ps := stats[mp.PlayerID]
+		if teamSize[mp.Team] == 1 {
+			ps.TotalPointsWon = sides[mp.Team].PointsWon
+			ps.SideMatchStats = sides[mp.Team].SideMatchStats
+		} else {
+			ps.TotalPointsWon = ps.ServicePointsWon
+		}
+		result.players = append(result.players, *ps)
+	}
+	for _, team := range []model.Team{model.TeamA, model.TeamB} {
+		if teamSize[team] > 1 {
+			result.teams = append(result.teams, *sides[team])
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+)
+
+// gameEvents builds the points of consecutive games, each served in turn by
+// the given servers.
+func gameEvents(matchID uuid.UUID, servers []uuid.UUID, games ...string) []model.PointEvent {
+	var events []model.PointEvent
+	start := time.Now()
+	for i, game := range games {
+		for _, e := range pointEvents(matchID, servers[i%len(servers)], start, game) {
+			e.Seq = len(events) + 1
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+func TestComputeMatchStatsSingles(t *testing.T) {
+	match, players := newTestMatch(model.MatchModeStandard)
+	a, b := players[0].PlayerID, players[1].PlayerID
+
+	// A holds to love, then breaks B in a deuce game after saving
+	// three break points and converting the fourth
+	events := gameEvents(match.ID, []uuid.UUID{a, b}, "AAAA", "AAABBBBAAA")
+	stats, err := computeMatchStats(match, players, nil, events)
+	if err != nil {
+		t.Fatalf("computeMatchStats: %v", err)
+	}
+	pa, pb := stats.players[0], stats.players[1]
+
+	if pa.ServiceGamesPlayed != 1 || pa.ServiceGamesHeld != 1 || pa.ServicePointsWon != 4 {
+		t.Errorf("A serve: expected 1 game held with 4 points, got %+v", pa)
+	}
+	if pb.ServiceGamesPlayed != 1 || pb.ServiceGamesHeld != 0 {
+		t.Errorf("B serve: expected 1 game broken, got %+v", pb)
+	}
+	if pb.BreakPointsFaced != 4 || pb.BreakPointsSaved != 3 {
+		t.Errorf("expected B to save 3 of 4 break points, got %d of %d", pb.BreakPointsSaved, pb.BreakPointsFaced)
+	}
+	if pa.BreakPointChances != 4 || pa.BreakPointsConverted != 1 {
+		t.Errorf("expected A to convert 1 of 4 break points, got %d of %d", pa.BreakPointsConverted, pa.BreakPointChances)
+	}
+	if pa.ReturnPointsPlayed != 10 || pa.ReturnPointsWon != 6 || pb.ReturnPointsPlayed != 4 || pb.ReturnPointsWon != 0 {
+		t.Errorf("unexpected return points: A %d/%d, B %d/%d",
+			pa.ReturnPointsWon, pa.ReturnPointsPlayed, pb.ReturnPointsWon, pb.ReturnPointsPlayed)
+	}
+	if pa.DeuceGamesPlayed != 1 || pa.DeuceGamesWon != 1 || pb.DeuceGamesWon != 0 {
+		t.Errorf("expected one deuce game won by A, got %+v", pa.SideMatchStats)
+	}
+	if pa.LongestPointStreak != 7 || pb.LongestPointStreak != 4 {
+		t.Errorf("expected streaks of 7 and 4, got %d and %d", pa.LongestPointStreak, pb.LongestPointStreak)
+	}
+
+	// Alone on their side, a player is credited with all of its points
+	if pa.TotalPointsWon != 10 || pb.TotalPointsWon != 4 {
+		t.Errorf("expected 10 and 4 points won, got %d and %d", pa.TotalPointsWon, pb.TotalPointsWon)
+	}
+	if len(stats.teams) != 0 {
+		t.Errorf("expected no team blocks in singles, got %d", len(stats.teams))
+	}
+	if stats.gamesA != 2 || stats.gamesB != 0 {
+		t.Errorf("expected games 2-0, got %d-%d", stats.gamesA, stats.gamesB)
+	}
+}
+
+func TestComputeMatchStatsLastFourGames(t *testing.T) {
+	match, players := newTestMatch(model.MatchModeStandard)
+	a, b := players[0].PlayerID, players[1].PlayerID
+
+	// A wins the first set 6-0; its last 4 games are the ones B serves
+	// to 15 and A serves to love
+	events := gameEvents(match.ID, []uuid.UUID{a, b},
+		"AAAA", "AAAA", "AAAA", "BAAAA", "AAAA", "BAAAA", "AAAA")
+	stats, err := computeMatchStats(match, players, nil, events)
+	if err != nil {
+		t.Fatalf("computeMatchStats: %v", err)
+	}
+	pa := stats.players[0]
+
+	// Games 3-6 of the first set, plus the only game of the second
+	if pa.Last4PointsPlayed != 18+4 || pa.Last4PointsWon != 16+4 {
+		t.Errorf("expected A to win 20 of 22 closing points, got %d of %d", pa.Last4PointsWon, pa.Last4PointsPlayed)
+	}
+}
+
+func TestComputeMatchStatsDoubles(t *testing.T) {
+	a1, a2, b1, b2 := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	match := &model.Match{ID: uuid.New(), MatchType: model.MatchTypeDoubles, Mode: model.MatchModeStandard}
+	players := []model.MatchPlayer{
+		{MatchID: match.ID, PlayerID: a1, Team: model.TeamA},
+		{MatchID: match.ID, PlayerID: a2, Team: model.TeamA},
+		{MatchID: match.ID, PlayerID: b1, Team: model.TeamB},
+		{MatchID: match.ID, PlayerID: b2, Team: model.TeamB},
+	}
+
+	events := gameEvents(match.ID, []uuid.UUID{a1, b1, a2, b2}, "AAAA", "BBBB", "AAABA", "BBBB")
+	stats, err := computeMatchStats(match, players, nil, events)
+	if err != nil {
+		t.Fatalf("computeMatchStats: %v", err)
+	}
+
+	// Partners are only credited with the points won on their own serve
+	if stats.players[0].TotalPointsWon != 4 || stats.players[1].TotalPointsWon != 4 {
+		t.Errorf("expected 4 points each for A's players, got %d and %d",
+			stats.players[0].TotalPointsWon, stats.players[1].TotalPointsWon)
+	}
+	if stats.players[0].ReturnPointsPlayed != 0 {
+		t.Error("expected side stats only in the team block for doubles")
+	}
+
+	if len(stats.teams) != 2 {
+		t.Fatalf("expected a block per doubles side, got %d", len(stats.teams))
+	}
+	teamA := stats.teams[0]
+	if teamA.Team != model.TeamA || len(teamA.PlayerIDs) != 2 || teamA.PointsWon != 8 ||
+		teamA.ServiceGamesPlayed != 2 || teamA.ServiceGamesHeld != 2 {
+		t.Errorf("unexpected team A block: %+v", teamA)
+	}
+}
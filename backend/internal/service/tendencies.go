@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"time"
@@ -18,20 +19,38 @@ type DateFilter struct {
 	EndDate   time.Time
 }
 
+// TendencyScope limits venue tendencies to one court or one surface.
+// NoCourt limits them to matches played without a court.
+type TendencyScope struct {
+	CourtID *uuid.UUID
+	Surface *model.Surface
+	NoCourt bool
+}
+
+// ErrInvalidBreakdown is returned for an unknown tendency breakdown.
+var ErrInvalidBreakdown = errors.New("breakdown must be court or surface")
+
+// ErrCourtNotFound is returned when a tendency scope names a court that is
+// not at the venue.
+var ErrCourtNotFound = errors.New("court not found")
+
 // TendenciesService handles venue tendency business logic.
 type TendenciesService struct {
 	tendenciesRepo *repository.TendenciesRepository
 	venueRepo      *repository.VenueRepository
+	courtRepo      *repository.CourtRepository
 }
 
 // NewTendenciesService creates a new tendencies service.
 func NewTendenciesService(
 	tendenciesRepo *repository.TendenciesRepository,
 	venueRepo *repository.VenueRepository,
+	courtRepo *repository.CourtRepository,
 ) *TendenciesService {
 	return &TendenciesService{
 		tendenciesRepo: tendenciesRepo,
 		venueRepo:      venueRepo,
+		courtRepo:      courtRepo,
 	}
 }
 
@@ -41,45 +60,159 @@ func NewTendenciesService(
 // - Players: Minimum 5 matches at venue
 // - All metrics are aggregated and deterministic
 // - Results ordered alphabetically (neutral ordering, no rankings)
-func (s *TendenciesService) GetVenueTendencies(ctx context.Context, venueID uuid.UUID, dateFilter DateFilter) (*model.VenueTendencies, error) {
+// The scope optionally limits the matches to one court or surface.
+func (s *TendenciesService) GetVenueTendencies(ctx context.Context, venueID uuid.UUID, dateFilter DateFilter, scope TendencyScope) (*model.VenueTendencies, error) {
 	// Validate venue exists
 	venue, err := s.venueRepo.GetByID(ctx, venueID)
 	if err != nil {
 		return nil, fmt.Errorf("venue not found: %w", err)
 	}
 
-	// Convert to repository date filter
+	if scope.CourtID != nil {
+		court, err := s.courtRepo.GetByID(ctx, *scope.CourtID)
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrCourtNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+		if court.VenueID != venueID {
+			return nil, ErrCourtNotFound
+		}
+	}
+
+	teamTendencies, playerTendencies, err := s.tendencies(ctx, venueID, dateFilter, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.VenueTendencies{
+		VenueID:          venue.ID,
+		VenueName:        venue.Name,
+		CourtID:          scope.CourtID,
+		Surface:          scope.Surface,
+		TeamTendencies:   teamTendencies,
+		PlayerTendencies: playerTendencies,
+	}, nil
+}
+
+// GetVenueTendencyBreakdown splits a venue's tendencies by court or by
+// surface. Courts are listed whether or not they are active, since past
+// matches were played on them, followed by matches played without a court;
+// surfaces are those of the venue and its courts.
+func (s *TendenciesService) GetVenueTendencyBreakdown(ctx context.Context, venueID uuid.UUID, dateFilter DateFilter, by string) (*model.VenueTendencyBreakdown, error) {
+	if by != "court" && by != "surface" {
+		return nil, ErrInvalidBreakdown
+	}
+
+	venue, err := s.venueRepo.GetByID(ctx, venueID)
+	if err != nil {
+		return nil, fmt.Errorf("venue not found: %w", err)
+	}
+
+	courts, err := s.courtRepo.ListByVenue(ctx, venueID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, scopes := breakdownGroups(venue, courts, by)
+	for i := range groups {
+		teams, players, err := s.tendencies(ctx, venueID, dateFilter, scopes[i])
+		if err != nil {
+			return nil, err
+		}
+		groups[i].TeamTendencies = teams
+		groups[i].PlayerTendencies = players
+	}
+
+	return &model.VenueTendencyBreakdown{
+		VenueID:   venue.ID,
+		VenueName: venue.Name,
+		By:        by,
+		Groups:    groups,
+	}, nil
+}
+
+// breakdownGroups returns the groups of a venue tendency breakdown, without
+// tendencies, and the scope each group covers. Grouping by court ends with a
+// group for matches without a court, including those whose court was deleted.
+func breakdownGroups(venue *model.Venue, courts []model.Court, by string) ([]model.VenueTendencyGroup, []TendencyScope) {
+	var groups []model.VenueTendencyGroup
+	var scopes []TendencyScope
+	if by == "court" {
+		for i := range courts {
+			court := &courts[i]
+			groups = append(groups, model.VenueTendencyGroup{Court: court, Surface: court.EffectiveSurface(venue)})
+			scopes = append(scopes, TendencyScope{CourtID: &court.ID})
+		}
+		groups = append(groups, model.VenueTendencyGroup{Surface: venue.Surface})
+		scopes = append(scopes, TendencyScope{NoCourt: true})
+		return groups, scopes
+	}
+
+	for _, surface := range venueSurfaces(venue, courts) {
+		surface := surface
+		groups = append(groups, model.VenueTendencyGroup{Surface: surface})
+		scopes = append(scopes, TendencyScope{Surface: &surface})
+	}
+	return groups, scopes
+}
+
+// venueSurfaces returns the distinct surfaces played on at a venue, the
+// venue's own surface first.
+func venueSurfaces(venue *model.Venue, courts []model.Court) []model.Surface {
+	surfaces := []model.Surface{venue.Surface}
+	for i := range courts {
+		surface := courts[i].EffectiveSurface(venue)
+		seen := false
+		for _, s := range surfaces {
+			if s == surface {
+				seen = true
+				break
+			}
+		}
+		if !seen {
+			surfaces = append(surfaces, surface)
+		}
+	}
+	return surfaces
+}
+
+// tendencies retrieves the team and player tendencies for matches at a venue
+// within a date filter and scope.
+func (s *TendenciesService) tendencies(ctx context.Context, venueID uuid.UUID, dateFilter DateFilter, scope TendencyScope) ([]model.VenueTeamTendency, []model.VenuePlayerTendency, error) {
+	// Convert to repository filters
 	repoFilter := repository.DateFilter{
 		Enabled:   dateFilter.Enabled,
 		StartDate: dateFilter.StartDate,
 		EndDate:   dateFilter.EndDate,
 	}
+	repoScope := repository.MatchScope{
+		CourtID: scope.CourtID,
+		Surface: scope.Surface,
+		NoCourt: scope.NoCourt,
+	}
 
 	// Get team tendencies
-	teamTendencies, err := s.getTeamTendencies(ctx, venueID, repoFilter)
+	teamTendencies, err := s.getTeamTendencies(ctx, venueID, repoFilter, repoScope)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get team tendencies: %w", err)
+		return nil, nil, fmt.Errorf("failed to get team tendencies: %w", err)
 	}
 
 	// Get player tendencies
-	playerTendencies, err := s.getPlayerTendencies(ctx, venueID, repoFilter)
+	playerTendencies, err := s.getPlayerTendencies(ctx, venueID, repoFilter, repoScope)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get player tendencies: %w", err)
+		return nil, nil, fmt.Errorf("failed to get player tendencies: %w", err)
 	}
 
-	return &model.VenueTendencies{
-		VenueID:          venue.ID,
-		VenueName:        venue.Name,
-		TeamTendencies:   teamTendencies,
-		PlayerTendencies: playerTendencies,
-	}, nil
+	return teamTendencies, playerTendencies, nil
 }
 
 // getTeamTendencies retrieves and filters team tendencies.
 // Per spec Section 3: Team eligibility requires at least 3 matches at venue.
 // Per spec Section 4: Applies to doubles matches only.
-func (s *TendenciesService) getTeamTendencies(ctx context.Context, venueID uuid.UUID, dateFilter repository.DateFilter) ([]model.VenueTeamTendency, error) {
-	rawStats, err := s.tendenciesRepo.GetTeamStatsAtVenue(ctx, venueID, dateFilter)
+func (s *TendenciesService) getTeamTendencies(ctx context.Context, venueID uuid.UUID, dateFilter repository.DateFilter, scope repository.MatchScope) ([]model.VenueTeamTendency, error) {
+	rawStats, err := s.tendenciesRepo.GetTeamStatsAtVenue(ctx, venueID, dateFilter, scope)
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +227,7 @@ func (s *TendenciesService) getTeamTendencies(ctx context.Context, venueID uuid.
 
 		// Get serve stats for this team
 		firstServesIn, _, firstServePointsWon, err := s.tendenciesRepo.GetTeamServeStatsAtVenue(
-			ctx, venueID, ts.Player1ID, ts.Player2ID, dateFilter,
+			ctx, venueID, ts.Player1ID, ts.Player2ID, dateFilter, scope,
 		)
 		if err != nil {
 			return nil, err
@@ -116,9 +249,9 @@ func (s *TendenciesService) getTeamTendencies(ctx context.Context, venueID uuid.
 			tendency.WinPercentage = float64(ts.MatchesWon) / float64(ts.MatchesPlayed) * 100
 		}
 
-		// Average games per match
-		if ts.MatchesPlayed > 0 {
-			tendency.AvgGamesPerMatch = float64(ts.TotalGames) / float64(ts.MatchesPlayed)
+		// Average games per match, over matches with points
+		if ts.TrackedMatches > 0 {
+			tendency.AvgGamesPerMatch = float64(ts.TotalGames) / float64(ts.TrackedMatches)
 		}
 
 		// First serve points won percentage
@@ -149,8 +282,8 @@ func (s *TendenciesService) getTeamTendencies(ctx context.Context, venueID uuid.
 // getPlayerTendencies retrieves and filters player tendencies.
 // Per spec Section 3: Player eligibility requires at least 5 matches at venue.
 // Per spec Section 5: NO win percentage - explicitly forbidden.
-func (s *TendenciesService) getPlayerTendencies(ctx context.Context, venueID uuid.UUID, dateFilter repository.DateFilter) ([]model.VenuePlayerTendency, error) {
-	rawStats, err := s.tendenciesRepo.GetPlayerStatsAtVenue(ctx, venueID, dateFilter)
+func (s *TendenciesService) getPlayerTendencies(ctx context.Context, venueID uuid.UUID, dateFilter repository.DateFilter, scope repository.MatchScope) ([]model.VenuePlayerTendency, error) {
+	rawStats, err := s.tendenciesRepo.GetPlayerStatsAtVenue(ctx, venueID, dateFilter, scope)
 	if err != nil {
 		return nil, err
 	}
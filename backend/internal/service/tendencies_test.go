@@ -233,3 +233,49 @@ func TestFirstServePercentageCalculation(t *testing.T) {
 		})
 	}
 }
+
+func TestVenueSurfaces(t *testing.T) {
+	clay := model.SurfaceClay
+	hard := model.SurfaceHard
+	venue := &model.Venue{Surface: model.SurfaceHard}
+	courts := []model.Court{
+		{Name: "Court 1"},
+		{Name: "Court 2", Surface: &clay},
+		{Name: "Court 3", Surface: &hard},
+		{Name: "Court 4", Surface: &clay},
+	}
+
+	got := venueSurfaces(venue, courts)
+	if len(got) != 2 || got[0] != model.SurfaceHard || got[1] != model.SurfaceClay {
+		t.Errorf("expected [hard clay], got %v", got)
+	}
+
+	if s := courts[0].EffectiveSurface(venue); s != model.SurfaceHard {
+		t.Errorf("court without surface should use the venue's, got %s", s)
+	}
+}
+
+func TestBreakdownGroups(t *testing.T) {
+	clay := model.SurfaceClay
+	venue := &model.Venue{Surface: model.SurfaceHard}
+	courts := []model.Court{
+		{ID: uuid.New(), Name: "Court 1"},
+		{ID: uuid.New(), Name: "Court 2", Surface: &clay},
+	}
+
+	groups, scopes := breakdownGroups(venue, courts, "court")
+	if len(groups) != 3 || len(scopes) != 3 {
+		t.Fatalf("expected two courts and matches without a court, got %d groups", len(groups))
+	}
+	if groups[1].Court != &courts[1] || groups[1].Surface != model.SurfaceClay || *scopes[1].CourtID != courts[1].ID {
+		t.Errorf("expected the second court on clay, got %+v", groups[1])
+	}
+	if groups[2].Court != nil || groups[2].Surface != model.SurfaceHard || !scopes[2].NoCourt || scopes[2].CourtID != nil {
+		t.Errorf("expected a last group for matches without a court, got %+v scoped %+v", groups[2], scopes[2])
+	}
+
+	groups, scopes = breakdownGroups(venue, courts, "surface")
+	if len(groups) != 2 || groups[0].Surface != model.SurfaceHard || *scopes[1].Surface != model.SurfaceClay || scopes[1].NoCourt {
+		t.Errorf("expected hard and clay groups, got %+v", groups)
+	}
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/scoring"
+)
+
+// GetTimeline returns a page of a match's point-by-point timeline.
+// A limit of 0 returns every point from offset onwards.
+func (s *MatchService) GetTimeline(ctx context.Context, matchID uuid.UUID, offset, limit int) (*model.MatchTimeline, error) {
+	match, err := s.matchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("match not found: %w", err)
+	}
+
+	players, err := s.matchRepo.GetMatchPlayers(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get players: %w", err)
+	}
+
+	events, err := s.matchRepo.GetEvents(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+
+	points, err := buildTimeline(match, players, events)
+	if err != nil {
+		return nil, err
+	}
+
+	total := len(points)
+	if offset > total {
+		offset = total
+	}
+	end := total
+	if limit > 0 && offset+limit < total {
+		end = offset + limit
+	}
+
+	return &model.MatchTimeline{
+		MatchID: matchID,
+		Total:   total,
+		Offset:  offset,
+		Limit:   limit,
+		Points:  points[offset:end],
+	}, nil
+}
+
+// buildTimeline replays a match and describes every scored point.
+// Points recorded after the match was decided are left out, as they never
+// changed the score.
+func buildTimeline(match *model.Match, players []model.MatchPlayer, events []model.PointEvent) ([]model.TimelinePoint, error) {
+	replay, err := newMatchReplay(match, players)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := make(map[uuid.UUID]model.Team, len(players))
+	for _, mp := range players {
+		teams[mp.PlayerID] = mp.Team
+	}
+
+	points := make([]model.TimelinePoint, 0, len(events))
+	for _, event := range events {
+		if replay.applied[event.ID] || replay.state.Completed {
+			continue
+		}
+
+		prev := replay.state
+		prevTimestamp := replay.lastTimestamp
+		replay.apply(event)
+		next := replay.state
+
+		serverTeam := teams[event.ServerPlayerID]
+
+		point := model.TimelinePoint{
+			Number:         len(points) + 1,
+			EventID:        event.ID,
+			Timestamp:      event.Timestamp,
+			ServerPlayerID: event.ServerPlayerID,
+			ServerTeam:     serverTeam,
+			ServeType:      event.ServeType,
+			WinnerTeam:     event.PointWinnerTeam,
+			SetNumber:      prev.CurrentSet,
+			GameNumber:     prev.CurrentGame.GameNumber,
+			Before:         timelineScore(prev),
+			After:          timelineScore(next),
+		}
+
+		if len(points) > 0 {
+			elapsed := event.Timestamp.Sub(prevTimestamp).Seconds()
+			point.ElapsedSeconds = &elapsed
+		}
+
+		winner := event.PointWinnerTeam
+		if gameEnded(prev, next) {
+			point.GameWonBy = &winner
+			point.Break = serverTeam != "" && winner != serverTeam
+		}
+		if next.SetsA+next.SetsB > prev.SetsA+prev.SetsB {
+			point.SetWonBy = &winner
+		}
+		if next.Completed {
+			point.MatchWonBy = &winner
+		}
+
+		markPressure(&point, prev, serverTeam)
+		points = append(points, point)
+	}
+
+	return points, nil
+}
+
+// markPressure flags break, set and match points by scoring the point
+// hypothetically for each team.
+func markPressure(point *model.TimelinePoint, prev *scoring.MatchState, serverTeam model.Team) {
+	for _, team := range []model.Team{model.TeamA, model.TeamB} {
+		hyp, err := scoring.ScorePoint(prev, scoring.Team(team))
+		if err != nil {
+			continue
+		}
+
+		t := team
+		if hyp.Completed {
+			point.MatchPointFor = &t
+		}
+		if hyp.SetsA+hyp.SetsB > prev.SetsA+prev.SetsB {
+			point.SetPointFor = &t
+		}
+		if serverTeam != "" && team != serverTeam && gameEnded(prev, hyp) {
+			point.BreakPoint = true
+		}
+	}
+}
+
+// timelineScore converts a scoring state to its timeline representation.
+func timelineScore(state *scoring.MatchState) model.TimelineScore {
+	display := scoring.GetMatchDisplay(state)
+	return model.TimelineScore{
+		SetsA:   state.SetsA,
+		SetsB:   state.SetsB,
+		GamesA:  state.GamesA,
+		GamesB:  state.GamesB,
+		PointsA: display.Points.A,
+		PointsB: display.Points.B,
+	}
+}
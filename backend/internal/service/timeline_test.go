@@ -0,0 +1,85 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+)
+
+func TestBuildTimelineBreakPoints(t *testing.T) {
+	match, players := newTestMatch(model.MatchModeStandard)
+	server := players[0].PlayerID
+
+	// Team A serves and B breaks to love
+	events := pointEvents(match.ID, server, time.Now(), "BBBB")
+	points, err := buildTimeline(match, players, events)
+	if err != nil {
+		t.Fatalf("buildTimeline: %v", err)
+	}
+	if len(points) != 4 {
+		t.Fatalf("expected 4 points, got %d", len(points))
+	}
+
+	if points[0].ElapsedSeconds != nil {
+		t.Error("expected no elapsed time for the first point")
+	}
+	if points[1].ElapsedSeconds == nil || *points[1].ElapsedSeconds != 1 {
+		t.Errorf("expected 1s elapsed, got %v", points[1].ElapsedSeconds)
+	}
+	if points[1].BreakPoint {
+		t.Error("0-15 is not a break point")
+	}
+	if !points[3].BreakPoint {
+		t.Error("0-40 on serve is a break point")
+	}
+
+	last := points[3]
+	if last.Before.PointsB != "40" || last.After.GamesB != 1 {
+		t.Errorf("unexpected score around the game point: %+v -> %+v", last.Before, last.After)
+	}
+	if last.GameWonBy == nil || *last.GameWonBy != model.TeamB || !last.Break {
+		t.Error("expected the receiving team to break serve")
+	}
+	if last.ServerTeam != model.TeamA {
+		t.Errorf("expected server team A, got %s", last.ServerTeam)
+	}
+}
+
+func TestBuildTimelineSetAndMatchPoints(t *testing.T) {
+	match, players := newTestMatch(model.MatchModeStandard)
+
+	// Two 6-0 sets to A, plus a stray point after the match was decided
+	seq := strings.Repeat("AAAA", 12) + "B"
+	events := pointEvents(match.ID, players[0].PlayerID, time.Now(), seq)
+	points, err := buildTimeline(match, players, events)
+	if err != nil {
+		t.Fatalf("buildTimeline: %v", err)
+	}
+	if len(points) != 48 {
+		t.Fatalf("expected points after the match to be left out, got %d", len(points))
+	}
+
+	setPoint := points[23]
+	if setPoint.SetPointFor == nil || *setPoint.SetPointFor != model.TeamA {
+		t.Error("expected set point for A at 5-0 40-0")
+	}
+	if setPoint.MatchPointFor != nil {
+		t.Error("first set point is not a match point")
+	}
+	if setPoint.SetWonBy == nil || setPoint.After.SetsA != 1 {
+		t.Errorf("expected A to take the first set, got %+v", setPoint.After)
+	}
+
+	matchPoint := points[47]
+	if matchPoint.MatchPointFor == nil || *matchPoint.MatchPointFor != model.TeamA {
+		t.Error("expected match point for A")
+	}
+	if matchPoint.MatchWonBy == nil || *matchPoint.MatchWonBy != model.TeamA {
+		t.Error("expected A to win the match on the last point")
+	}
+	if matchPoint.SetNumber != 2 {
+		t.Errorf("expected last point in set 2, got %d", matchPoint.SetNumber)
+	}
+}
@@ -0,0 +1,507 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/rating"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/scoring"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/tournament"
+)
+
+// ErrInvalidTournament is returned for input the tournament engine
+// rejects, e.g. teams that do not cover the tournament's players.
+var ErrInvalidTournament = errors.New("invalid tournament")
+
+// ErrFinalNotReady is returned when recording the final before its teams
+// are known.
+var ErrFinalNotReady = errors.New("final teams not set: prepare the final first")
+
+// tournamentStateErrors are the engine errors for actions the tournament's
+// current state does not allow.
+var tournamentStateErrors = []error{
+	tournament.ErrTeamsAlreadySet,
+	tournament.ErrNotInRoundRobin,
+	tournament.ErrRoundRobinIncomplete,
+	tournament.ErrNotInKnockout,
+	tournament.ErrSemifinalsIncomplete,
+	tournament.ErrMatchCompleted,
+	tournament.ErrMatchNotFound,
+	tournament.ErrMatchLinked,
+	tournament.ErrNoResult,
+	tournament.ErrResultLocked,
+}
+
+// tournamentSaveAttempts bounds how often a change is retried when another
+// device saved the tournament in between.
+const tournamentSaveAttempts = 3
+
+// TournamentService runs tournaments with the tournament engine and
+// stores them.
+type TournamentService struct {
+	repo       *repository.TournamentRepository
+	playerRepo *repository.PlayerRepository
+	venueRepo  *repository.VenueRepository
+	matches    *MatchService
+	ratings    *RatingService
+}
+
+// NewTournamentService creates a new tournament service.
+func NewTournamentService(
+	repo *repository.TournamentRepository,
+	playerRepo *repository.PlayerRepository,
+	venueRepo *repository.VenueRepository,
+	matches *MatchService,
+	ratings *RatingService,
+) *TournamentService {
+	return &TournamentService{
+		repo:       repo,
+		playerRepo: playerRepo,
+		venueRepo:  venueRepo,
+		matches:    matches,
+		ratings:    ratings,
+	}
+}
+
+// CreateTournamentRequest is the input for creating a tournament.
+type CreateTournamentRequest struct {
+	VenueID   uuid.UUID   `json:"venue_id"`
+	PlayerIDs []uuid.UUID `json:"player_ids"`
+}
+
+// SetTeamsRequest is the input for forming a tournament's teams.
+type SetTeamsRequest struct {
+	Mode tournament.TeamCreationMode `json:"mode"` // random, manual or balanced
+
+	// Seed makes random and balanced teams reproducible; defaults to now.
+	// The seed used is stored on the tournament.
+	Seed *int64 `json:"seed,omitempty"`
+
+	// Pairs are the teams in manual mode
+	Pairs [][2]uuid.UUID `json:"pairs,omitempty"`
+
+	// Together and Apart constrain balanced mode
+	Together [][2]uuid.UUID `json:"together,omitempty"`
+	Apart    [][2]uuid.UUID `json:"apart,omitempty"`
+
+	// AvoidRepeats steers random and balanced teams away from partners and
+	// opponents of the last LookbackDays (default 28) before the
+	// tournament was created
+	AvoidRepeats bool `json:"avoid_repeats,omitempty"`
+	LookbackDays int  `json:"lookback_days,omitempty"`
+}
+
+// RecordTournamentResultRequest is the result of a tournament match.
+type RecordTournamentResultRequest struct {
+	WinnerTeamID uuid.UUID `json:"winner_team_id"`
+
+	// Score is optional, from the match's team A side, e.g. "6-3 6-4" or
+	// "2-1" (short-format). Its games break ties in the standings.
+	Score string          `json:"score,omitempty"`
+	Mode  model.MatchMode `json:"mode,omitempty"` // Of the score; defaults to standard
+}
+
+// CreateTournament creates a tournament in the setup stage.
+func (s *TournamentService) CreateTournament(ctx context.Context, req CreateTournamentRequest) (*model.Tournament, error) {
+	if _, err := s.venueRepo.GetByID(ctx, req.VenueID); err != nil {
+		return nil, fmt.Errorf("%w: venue not found", ErrInvalidTournament)
+	}
+
+	seen := make(map[uuid.UUID]bool, len(req.PlayerIDs))
+	for _, id := range req.PlayerIDs {
+		if seen[id] {
+			return nil, fmt.Errorf("%w: player %s listed twice", ErrInvalidTournament, id)
+		}
+		seen[id] = true
+		if _, err := s.playerRepo.GetByID(ctx, id); err != nil {
+			return nil, fmt.Errorf("%w: player %s not found", ErrInvalidTournament, id)
+		}
+	}
+
+	state, err := tournament.NewTournament(req.VenueID, req.PlayerIDs)
+	if err != nil {
+		return nil, engineError(err)
+	}
+	if err := s.repo.Create(ctx, state); err != nil {
+		return nil, err
+	}
+	return tournamentView(state, 1), nil
+}
+
+// ListTournaments returns tournaments newest first, optionally at one venue.
+func (s *TournamentService) ListTournaments(ctx context.Context, venueID *uuid.UUID) ([]model.TournamentSummary, error) {
+	return s.repo.List(ctx, venueID)
+}
+
+// GetTournament returns a tournament.
+func (s *TournamentService) GetTournament(ctx context.Context, id uuid.UUID) (*model.Tournament, error) {
+	state, version, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return tournamentView(state, version), nil
+}
+
+// GetMatches returns a tournament's matches, round robin then knockout.
+func (s *TournamentService) GetMatches(ctx context.Context, id uuid.UUID) ([]model.TournamentMatch, error) {
+	t, err := s.GetTournament(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return t.Matches, nil
+}
+
+// GetNextMatch returns the next match to play, or nil if there is none
+// until the tournament moves on.
+func (s *TournamentService) GetNextMatch(ctx context.Context, id uuid.UUID) (*model.TournamentMatch, error) {
+	state, _, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	next := tournament.GetNextMatch(state)
+	if next == nil {
+		return nil, nil
+	}
+	view := matchView(*next)
+	return &view, nil
+}
+
+// GetStandings returns a tournament's standings and winner.
+func (s *TournamentService) GetStandings(ctx context.Context, id uuid.UUID) (*model.TournamentStandings, error) {
+	state, version, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	t := tournamentView(state, version)
+	standings := &model.TournamentStandings{
+		TournamentID: t.ID,
+		Stage:        t.Stage,
+		Standings:    t.Standings,
+		Completed:    t.Completed,
+	}
+	if t.WinnerTeamID != nil {
+		for i := range t.Teams {
+			if t.Teams[i].ID == *t.WinnerTeamID {
+				standings.Winner = &t.Teams[i]
+			}
+		}
+	}
+	return standings, nil
+}
+
+// SetTeams forms the teams and starts the round robin.
+func (s *TournamentService) SetTeams(ctx context.Context, id uuid.UUID, req SetTeamsRequest) (*model.Tournament, error) {
+	state, _, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if state.Stage != tournament.StageSetup {
+		return nil, tournament.ErrTeamsAlreadySet
+	}
+
+	seed := time.Now().UnixNano()
+	if req.Seed != nil {
+		seed = *req.Seed
+	}
+
+	var history *tournament.PairingHistory
+	if req.AvoidRepeats && req.Mode != tournament.ModeManual {
+		lookback := DefaultPairingLookback
+		if req.LookbackDays > 0 {
+			lookback = time.Duration(req.LookbackDays) * 24 * time.Hour
+		}
+		if history, err = s.matches.GetPairingHistory(ctx, state.CreatedAt, lookback); err != nil {
+			return nil, err
+		}
+	}
+
+	var teams []tournament.Team
+	switch req.Mode {
+	case tournament.ModeRandom:
+		if history != nil {
+			teams, err = tournament.GenerateFreshTeams(state.PlayerIDs, history, seed)
+		} else {
+			teams, err = tournament.GenerateRandomTeams(state.PlayerIDs, seed)
+		}
+	case tournament.ModeManual:
+		teams, err = tournament.GenerateManualTeams(req.Pairs)
+	case tournament.ModeBalanced:
+		var players []tournament.PlayerStrength
+		if players, err = s.ratings.doublesStrengths(ctx, state.PlayerIDs); err != nil {
+			return nil, err
+		}
+		constraints := tournament.TeamConstraints{Together: req.Together, Apart: req.Apart}
+		teams, err = tournament.GenerateBalancedTeams(players, constraints, history, seed)
+	default:
+		return nil, fmt.Errorf("%w: mode must be random, manual or balanced", ErrInvalidTournament)
+	}
+	if err != nil {
+		return nil, engineError(err)
+	}
+	if err := checkTeamPlayers(state.PlayerIDs, teams); err != nil {
+		return nil, err
+	}
+
+	return updateTournament(ctx, s.repo, id, func(state *tournament.TournamentState) (*tournament.TournamentState, error) {
+		next, err := tournament.SetTeams(state, teams)
+		if err != nil {
+			return nil, err
+		}
+		if req.Mode != tournament.ModeManual {
+			next.TeamSeed = &seed
+		}
+		return next, nil
+	})
+}
+
+// RecordResult records the winner, and optionally score, of a tournament
+// match.
+func (s *TournamentService) RecordResult(ctx context.Context, id, matchID uuid.UUID, req RecordTournamentResultRequest) (*model.Tournament, error) {
+	var score *scoring.MatchScore
+	if req.Score != "" {
+		var err error
+		if score, err = parseScore(req.Mode, req.Score); err != nil {
+			return nil, fmt.Errorf("%w: invalid score: %v", ErrInvalidTournament, err)
+		}
+	}
+
+	return updateTournament(ctx, s.repo, id, func(state *tournament.TournamentState) (*tournament.TournamentState, error) {
+		result, err := tournamentResult(state, matchID, req.WinnerTeamID)
+		if err != nil {
+			return nil, err
+		}
+		if score != nil {
+			winnerIsA := req.WinnerTeamID == findTournamentMatch(state, matchID).TeamAID
+			if (score.Winner == scoring.TeamA) != winnerIsA {
+				return nil, fmt.Errorf("%w: score does not match the winner", ErrInvalidTournament)
+			}
+			result = scoreResult(result, score, req.Mode == model.MatchModeShort, winnerIsA)
+		}
+		return tournament.RecordMatchResult(state, result)
+	})
+}
+
+// AdvanceToKnockout ranks the teams and draws the knockout stage once the
+// round robin is complete.
+func (s *TournamentService) AdvanceToKnockout(ctx context.Context, id uuid.UUID) (*model.Tournament, error) {
+	return updateTournament(ctx, s.repo, id, tournament.AdvanceToKnockout)
+}
+
+// PrepareFinal puts the semifinal winners into the final.
+func (s *TournamentService) PrepareFinal(ctx context.Context, id uuid.UUID) (*model.Tournament, error) {
+	return updateTournament(ctx, s.repo, id, tournament.PrepareFinal)
+}
+
+// updateTournament applies an engine step to the stored tournament and
+// saves it. If another device saved in between, the step is applied again
+// to its state, so e.g. a result recorded twice fails with
+// ErrMatchCompleted.
+func updateTournament(ctx context.Context, repo *repository.TournamentRepository, id uuid.UUID, step func(*tournament.TournamentState) (*tournament.TournamentState, error)) (*model.Tournament, error) {
+	return updateTournamentWith(ctx, repo, id, step, repo.Save)
+}
+
+// updateTournamentWith is updateTournament with the changed state stored by
+// save, which fails with repository.ErrVersionConflict to be retried.
+func updateTournamentWith(ctx context.Context, repo *repository.TournamentRepository, id uuid.UUID, step func(*tournament.TournamentState) (*tournament.TournamentState, error), save func(context.Context, *tournament.TournamentState, int) (int, error)) (*model.Tournament, error) {
+	var err error
+	for attempt := 0; attempt < tournamentSaveAttempts; attempt++ {
+		state, version, getErr := repo.Get(ctx, id)
+		if getErr != nil {
+			return nil, getErr
+		}
+
+		next, stepErr := step(state)
+		if stepErr != nil {
+			return nil, engineError(stepErr)
+		}
+		if next == state {
+			return tournamentView(state, version), nil
+		}
+
+		saved, saveErr := save(ctx, next, version)
+		if saveErr == nil {
+			return tournamentView(next, saved), nil
+		}
+		if !errors.Is(saveErr, repository.ErrVersionConflict) {
+			return nil, saveErr
+		}
+		err = saveErr
+	}
+	return nil, err
+}
+
+// tournamentResult builds the engine's result for a winner of a match.
+func tournamentResult(state *tournament.TournamentState, matchID, winnerTeamID uuid.UUID) (tournament.MatchResult, error) {
+	for _, m := range tournament.GetAllMatches(state) {
+		if m.ID != matchID {
+			continue
+		}
+		if m.Completed {
+			return tournament.MatchResult{}, tournament.ErrMatchCompleted
+		}
+		if m.TeamAID == uuid.Nil || m.TeamBID == uuid.Nil {
+			return tournament.MatchResult{}, ErrFinalNotReady
+		}
+
+		result := tournament.MatchResult{MatchID: matchID, WinnerTeamID: winnerTeamID}
+		switch winnerTeamID {
+		case m.TeamAID:
+			result.LoserTeamID = m.TeamBID
+		case m.TeamBID:
+			result.LoserTeamID = m.TeamAID
+		default:
+			return tournament.MatchResult{}, fmt.Errorf("%w: winner must be one of the match's teams", ErrInvalidTournament)
+		}
+		return result, nil
+	}
+	return tournament.MatchResult{}, tournament.ErrMatchNotFound
+}
+
+// scoreResult adds a score, given from the match's team A side, to a
+// result. Short-format matches have no sets.
+func scoreResult(result tournament.MatchResult, score *scoring.MatchScore, short, winnerIsA bool) tournament.MatchResult {
+	var setsA, setsB int
+	if !short {
+		setsA, setsB = score.SetsWon()
+	}
+	gamesA, gamesB := score.GamesWon()
+	if !winnerIsA {
+		setsA, setsB = setsB, setsA
+		gamesA, gamesB = gamesB, gamesA
+	}
+
+	result.WinnerSets, result.LoserSets = setsA, setsB
+	result.WinnerGames, result.LoserGames = gamesA, gamesB
+	return result
+}
+
+// checkTeamPlayers ensures the teams are made of exactly the tournament's
+// players.
+func checkTeamPlayers(playerIDs []uuid.UUID, teams []tournament.Team) error {
+	entered := make(map[uuid.UUID]bool, len(playerIDs))
+	for _, id := range playerIDs {
+		entered[id] = true
+	}
+	for _, t := range teams {
+		for _, id := range []uuid.UUID{t.Player1ID, t.Player2ID} {
+			if !entered[id] {
+				return fmt.Errorf("%w: player %s is not in the tournament", ErrInvalidTournament, id)
+			}
+		}
+	}
+	if len(teams)*2 != len(playerIDs) {
+		return fmt.Errorf("%w: teams must include every player", ErrInvalidTournament)
+	}
+	return nil
+}
+
+// engineError passes on errors for the tournament's state and marks any
+// other engine error as invalid input.
+func engineError(err error) error {
+	if errors.Is(err, ErrFinalNotReady) || errors.Is(err, ErrInvalidTournament) {
+		return err
+	}
+	for _, stateErr := range tournamentStateErrors {
+		if errors.Is(err, stateErr) {
+			return err
+		}
+	}
+	return fmt.Errorf("%w: %v", ErrInvalidTournament, err)
+}
+
+// doublesStrengths returns players' doubles ratings for balanced teams.
+// Unrated players count at the initial rating.
+func (s *RatingService) doublesStrengths(ctx context.Context, playerIDs []uuid.UUID) ([]tournament.PlayerStrength, error) {
+	stored, err := s.ratingRepo.Get(ctx, playerIDs, model.RatingKindDoubles)
+	if err != nil {
+		return nil, err
+	}
+	ratings := make(map[uuid.UUID]float64, len(stored))
+	for _, pr := range stored {
+		ratings[pr.PlayerID] = pr.Rating
+	}
+
+	players := make([]tournament.PlayerStrength, len(playerIDs))
+	for i, id := range playerIDs {
+		strength, ok := ratings[id]
+		if !ok {
+			strength = rating.DefaultRating
+		}
+		players[i] = tournament.PlayerStrength{PlayerID: id, Strength: strength}
+	}
+	return players, nil
+}
+
+// tournamentView converts engine state to the API model.
+func tournamentView(state *tournament.TournamentState, version int) *model.Tournament {
+	t := &model.Tournament{
+		ID:           state.ID,
+		VenueID:      state.VenueID,
+		PlayerIDs:    state.PlayerIDs,
+		Stage:        string(state.Stage),
+		Teams:        []model.TournamentTeam{},
+		Matches:      []model.TournamentMatch{},
+		Standings:    []model.TournamentStanding{},
+		TeamSeed:     state.TeamSeed,
+		WinnerTeamID: state.Winner,
+		Completed:    state.Completed,
+		Version:      version,
+		CreatedAt:    state.CreatedAt,
+	}
+	for _, team := range state.Teams {
+		t.Teams = append(t.Teams, model.TournamentTeam{
+			ID:         team.ID,
+			TeamNumber: team.TeamNumber,
+			Player1ID:  team.Player1ID,
+			Player2ID:  team.Player2ID,
+		})
+	}
+	for _, m := range tournament.GetAllMatches(state) {
+		t.Matches = append(t.Matches, matchView(m))
+	}
+	for _, st := range state.Standings {
+		t.Standings = append(t.Standings, model.TournamentStanding{
+			TeamID:    st.TeamID,
+			Rank:      st.Rank,
+			Played:    st.Played,
+			Won:       st.Won,
+			Lost:      st.Lost,
+			Points:    st.Points,
+			SetsWon:   st.SetsWon,
+			SetsLost:  st.SetsLost,
+			GamesWon:  st.GamesWon,
+			GamesLost: st.GamesLost,
+			TieBreak:  string(st.TieBreak),
+		})
+	}
+	return t
+}
+
+func matchView(m tournament.Match) model.TournamentMatch {
+	view := model.TournamentMatch{
+		ID:             m.ID,
+		Stage:          string(m.Stage),
+		MatchOrder:     m.MatchOrder,
+		ScoringMatchID: m.ScoringMatchID,
+		WinnerTeamID:   m.WinnerTeamID,
+		SetsA:          m.SetsA,
+		SetsB:          m.SetsB,
+		GamesA:         m.GamesA,
+		GamesB:         m.GamesB,
+		Completed:      m.Completed,
+	}
+	if m.TeamAID != uuid.Nil {
+		teamA := m.TeamAID
+		view.TeamAID = &teamA
+	}
+	if m.TeamBID != uuid.Nil {
+		teamB := m.TeamBID
+		view.TeamBID = &teamB
+	}
+	return view
+}
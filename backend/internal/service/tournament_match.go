@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/tournament"
+)
+
+// StartTournamentMatchRequest is the input for scoring a tournament match.
+// Venue, match type and teams come from the tournament.
+type StartTournamentMatchRequest struct {
+	Mode    model.MatchMode `json:"mode,omitempty"`    // Defaults to standard
+	Servers []uuid.UUID     `json:"servers,omitempty"` // Short-format serving order
+	BestOf  int             `json:"best_of,omitempty"` // Short-format games, 3 (default) or 5
+
+	// CourtID optionally places the match on a court at the venue
+	CourtID *uuid.UUID `json:"court_id,omitempty"`
+
+	// ScheduledAt creates a scheduled match to be started later
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
+}
+
+// StartMatch creates the scoring match a tournament match is played as.
+// When the scoring match completes its winner is recorded in the
+// tournament; if it is abandoned the tournament match can be started again.
+func (s *TournamentService) StartMatch(ctx context.Context, id, matchID uuid.UUID, req StartTournamentMatchRequest) (*model.Match, error) {
+	state, _, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	m := findTournamentMatch(state, matchID)
+	switch {
+	case m == nil:
+		return nil, tournament.ErrMatchNotFound
+	case m.Completed:
+		return nil, tournament.ErrMatchCompleted
+	case m.TeamAID == uuid.Nil || m.TeamBID == uuid.Nil:
+		return nil, ErrFinalNotReady
+	case m.ScoringMatchID != nil:
+		return nil, tournament.ErrMatchLinked
+	}
+
+	match, err := s.matches.CreateMatch(ctx, CreateMatchRequest{
+		VenueID:     state.VenueID,
+		MatchType:   model.MatchTypeDoubles,
+		Mode:        req.Mode,
+		Servers:     req.Servers,
+		BestOf:      req.BestOf,
+		TeamA:       teamPlayers(state.Teams, m.TeamAID),
+		TeamB:       teamPlayers(state.Teams, m.TeamBID),
+		CourtID:     req.CourtID,
+		ScheduledAt: req.ScheduledAt,
+	})
+	if errors.Is(err, ErrInvalidMatch) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTournament, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = updateTournament(ctx, s.repo, id, func(state *tournament.TournamentState) (*tournament.TournamentState, error) {
+		return tournament.LinkScoringMatch(state, matchID, &match.ID)
+	})
+	if err != nil {
+		// Someone else started or decided the match in between
+		if delErr := s.matches.DeleteMatch(ctx, match.ID); delErr != nil {
+			log.Printf("failed to remove unlinked match %s: %v", match.ID, delErr)
+		}
+		return nil, err
+	}
+	return match, nil
+}
+
+// completeScoredMatch completes a match with the result of replaying it.
+// The result is recorded in the tournament match it was played as in the
+// same transaction; completing the last semifinal puts its winners into
+// the final.
+func (s *MatchService) completeScoredMatch(ctx context.Context, match *model.Match, change repository.StatusChange) error {
+	// Result-only matches are stored with theirs and have no points to replay
+	if !match.ResultOnly {
+		replay, err := s.loadReplay(ctx, match.ID)
+		if err != nil {
+			return err
+		}
+		if winner, score := matchResult(replay); score != "" {
+			change.Result = &repository.MatchResult{Winner: winner, Score: score}
+		}
+	}
+
+	if change.Result == nil || change.Result.Winner == nil {
+		// The tournament match could never be decided or started again
+		_, _, err := s.tournamentRepo.GetLinkedMatch(ctx, match.ID)
+		if err == nil {
+			return fmt.Errorf("%w: a tournament match can only be completed with a winner, abandon it instead", ErrInvalidTransition)
+		}
+		if !errors.Is(err, repository.ErrNotFound) {
+			return err
+		}
+	}
+
+	scored := *match
+	if change.Result != nil {
+		scored.WinnerTeam = change.Result.Winner
+		scored.Score = change.Result.Score
+	}
+	err := s.changeScoredStatus(ctx, change, func(state *tournament.TournamentState, matchID uuid.UUID) (*tournament.TournamentState, error) {
+		return recordLinkedResult(state, matchID, &scored)
+	})
+	if err != nil {
+		return err
+	}
+
+	match.WinnerTeam = scored.WinnerTeam
+	match.Score = scored.Score
+	return nil
+}
+
+// changeScoredStatus changes the status of a scoring match. The tournament
+// match it was played as is changed by step in the same transaction, so
+// neither change is kept without the other.
+func (s *MatchService) changeScoredStatus(ctx context.Context, change repository.StatusChange, step func(state *tournament.TournamentState, matchID uuid.UUID) (*tournament.TournamentState, error)) error {
+	tournamentID, matchID, err := s.tournamentRepo.GetLinkedMatch(ctx, change.MatchID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return s.matchRepo.ChangeStatus(ctx, change)
+	}
+	if err != nil {
+		return err
+	}
+
+	changed := false
+	save := func(ctx context.Context, state *tournament.TournamentState, version int) (int, error) {
+		saved, err := s.tournamentRepo.SaveChangingStatus(ctx, state, version, change)
+		changed = err == nil
+		return saved, err
+	}
+	_, err = updateTournamentWith(ctx, s.tournamentRepo, tournamentID, func(state *tournament.TournamentState) (*tournament.TournamentState, error) {
+		return step(state, matchID)
+	}, save)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		// Released or decided in between, so there was nothing to save
+		return s.matchRepo.ChangeStatus(ctx, change)
+	}
+	return nil
+}
+
+// deleteScoredMatch deletes a scoring match. The tournament match it was
+// played as is released in the same transaction, so neither change is
+// kept without the other.
+func (s *MatchService) deleteScoredMatch(ctx context.Context, scoringMatchID uuid.UUID) error {
+	tournamentID, matchID, err := s.tournamentRepo.GetLinkedMatch(ctx, scoringMatchID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return s.matchRepo.Delete(ctx, scoringMatchID)
+	}
+	if err != nil {
+		return err
+	}
+
+	deleted := false
+	save := func(ctx context.Context, state *tournament.TournamentState, version int) (int, error) {
+		saved, err := s.tournamentRepo.SaveDeletingMatch(ctx, state, version, scoringMatchID)
+		deleted = err == nil
+		return saved, err
+	}
+	_, err = updateTournamentWith(ctx, s.tournamentRepo, tournamentID, func(state *tournament.TournamentState) (*tournament.TournamentState, error) {
+		return releaseScoredMatch(state, matchID, scoringMatchID)
+	}, save)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		// Released in between, so there was nothing to save
+		return s.matchRepo.Delete(ctx, scoringMatchID)
+	}
+	return nil
+}
+
+// recordLinkedResult records the result of a completed scoring match in
+// the tournament match it was played as, unless that match was released
+// or decided by hand in between.
+func recordLinkedResult(state *tournament.TournamentState, matchID uuid.UUID, scored *model.Match) (*tournament.TournamentState, error) {
+	m := findTournamentMatch(state, matchID)
+	if m == nil || m.ScoringMatchID == nil || *m.ScoringMatchID != scored.ID || m.Completed || scored.WinnerTeam == nil {
+		return state, nil
+	}
+	return recordScoredResult(state, matchID, scored)
+}
+
+// recordScoredResult records the winner and score of a completed scoring
+// match as the result of the tournament match it was played as. Team A of
+// the scoring match is the tournament match's team A. A score that isn't a
+// finished match, such as one completed before it was decided or one whose
+// result failed to store, is left out and only the winner is recorded.
+func recordScoredResult(state *tournament.TournamentState, matchID uuid.UUID, scored *model.Match) (*tournament.TournamentState, error) {
+	m := findTournamentMatch(state, matchID)
+	if m == nil || m.ScoringMatchID == nil || *m.ScoringMatchID != scored.ID {
+		return nil, tournament.ErrMatchNotFound
+	}
+
+	winnerIsA := *scored.WinnerTeam == model.TeamA
+	winnerTeamID := m.TeamAID
+	if !winnerIsA {
+		winnerTeamID = m.TeamBID
+	}
+	result, err := tournamentResult(state, matchID, winnerTeamID)
+	if err != nil {
+		return nil, err
+	}
+	if score, err := storedScore(scored); err == nil {
+		result = scoreResult(result, score, scored.Mode == model.MatchModeShort, winnerIsA)
+	}
+
+	next, err := tournament.RecordMatchResult(state, result)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Stage == tournament.StageSemi && tournament.AreSemifinalsComplete(next.KnockoutMatches) {
+		return tournament.PrepareFinal(next)
+	}
+	return next, nil
+}
+
+// releaseStep releases the tournament match a scoring match was played as,
+// taking back the result it decided.
+func releaseStep(scoringMatchID uuid.UUID) func(*tournament.TournamentState, uuid.UUID) (*tournament.TournamentState, error) {
+	return func(state *tournament.TournamentState, matchID uuid.UUID) (*tournament.TournamentState, error) {
+		return releaseScoredMatch(state, matchID, scoringMatchID)
+	}
+}
+
+// releaseScoredMatch clears a tournament match's link to a scoring match
+// and takes back the result it decided.
+func releaseScoredMatch(state *tournament.TournamentState, matchID, scoringMatchID uuid.UUID) (*tournament.TournamentState, error) {
+	m := findTournamentMatch(state, matchID)
+	if m == nil || m.ScoringMatchID == nil || *m.ScoringMatchID != scoringMatchID {
+		// Released in between
+		return state, nil
+	}
+
+	if m.Completed {
+		var err error
+		if state, err = tournament.UndoMatchResult(state, matchID); err != nil {
+			return nil, err
+		}
+	}
+	return tournament.LinkScoringMatch(state, matchID, nil)
+}
+
+// findTournamentMatch returns a copy of a tournament match, or nil.
+func findTournamentMatch(state *tournament.TournamentState, matchID uuid.UUID) *tournament.Match {
+	for _, m := range tournament.GetAllMatches(state) {
+		if m.ID == matchID {
+			return &m
+		}
+	}
+	return nil
+}
+
+// teamPlayers returns the players of a tournament team.
+func teamPlayers(teams []tournament.Team, teamID uuid.UUID) []uuid.UUID {
+	for _, t := range teams {
+		if t.ID == teamID {
+			return []uuid.UUID{t.Player1ID, t.Player2ID}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/repository"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/tournament"
+)
+
+// playScored links a tournament match to a new scoring match and records
+// a straight-sets win for one side of it.
+func playScored(t *testing.T, state *tournament.TournamentState, matchID uuid.UUID, winner model.Team) (*tournament.TournamentState, uuid.UUID) {
+	t.Helper()
+	scoringID := uuid.New()
+	state, err := tournament.LinkScoringMatch(state, matchID, &scoringID)
+	if err != nil {
+		t.Fatalf("LinkScoringMatch: %v", err)
+	}
+	score := "6-4 6-3"
+	if winner == model.TeamB {
+		score = "4-6 3-6"
+	}
+	scored := &model.Match{ID: scoringID, Mode: model.MatchModeStandard, WinnerTeam: &winner, Score: score}
+	state, err = recordScoredResult(state, matchID, scored)
+	if err != nil {
+		t.Fatalf("recordScoredResult: %v", err)
+	}
+	return state, scoringID
+}
+
+func TestRecordScoredResult(t *testing.T) {
+	state := newTestTournament(t)
+	m := state.RoundRobinMatches[0]
+
+	state, scoringID := playScored(t, state, m.ID, model.TeamB)
+	played := state.RoundRobinMatches[0]
+	if !played.Completed || played.WinnerTeamID == nil || *played.WinnerTeamID != m.TeamBID {
+		t.Errorf("expected team B of the scoring match to win the tournament match, got %+v", played)
+	}
+	if played.GamesA != 7 || played.GamesB != 12 || played.SetsA != 0 || played.SetsB != 2 {
+		t.Errorf("expected the score 4-6 3-6 on the match, got %+v", played)
+	}
+	if st := tournament.GetStandingByTeamID(state.Standings, m.TeamBID); st.GamesWon != 12 || st.GamesLost != 7 || st.SetsWon != 2 {
+		t.Errorf("expected the winner's standing to count the score, got %+v", *st)
+	}
+
+	winner := model.TeamA
+	scored := &model.Match{ID: scoringID, Mode: model.MatchModeStandard, WinnerTeam: &winner, Score: "6-0 6-0"}
+	if _, err := recordScoredResult(state, m.ID, scored); !errors.Is(err, tournament.ErrMatchCompleted) {
+		t.Errorf("expected ErrMatchCompleted, got %v", err)
+	}
+
+	other := state.RoundRobinMatches[1]
+	scored.ID = uuid.New()
+	if _, err := recordScoredResult(state, other.ID, scored); !errors.Is(err, tournament.ErrMatchNotFound) {
+		t.Errorf("expected ErrMatchNotFound for an unlinked match, got %v", err)
+	}
+}
+
+func TestRecordScoredResultWithoutScore(t *testing.T) {
+	for _, score := range []string{"6-4 3-2", ""} {
+		state := newTestTournament(t)
+		m := state.RoundRobinMatches[0]
+		scoringID := uuid.New()
+		state, err := tournament.LinkScoringMatch(state, m.ID, &scoringID)
+		if err != nil {
+			t.Fatalf("LinkScoringMatch: %v", err)
+		}
+
+		// Completed before it was decided, or without a stored score
+		winner := model.TeamA
+		scored := &model.Match{ID: scoringID, Mode: model.MatchModeStandard, WinnerTeam: &winner, Score: score}
+		state, err = recordScoredResult(state, m.ID, scored)
+		if err != nil {
+			t.Fatalf("recordScoredResult(%q): %v", score, err)
+		}
+		played := state.RoundRobinMatches[0]
+		if !played.Completed || played.WinnerTeamID == nil || *played.WinnerTeamID != m.TeamAID || played.GamesA != 0 || played.SetsA != 0 {
+			t.Errorf("expected a winner-only result for %q, got %+v", score, played)
+		}
+	}
+}
+
+func TestRecordLinkedResult(t *testing.T) {
+	state := newTestTournament(t)
+	m := state.RoundRobinMatches[0]
+	scoringID := uuid.New()
+	state, err := tournament.LinkScoringMatch(state, m.ID, &scoringID)
+	if err != nil {
+		t.Fatalf("LinkScoringMatch: %v", err)
+	}
+	winner := model.TeamA
+	scored := &model.Match{ID: scoringID, Mode: model.MatchModeStandard, WinnerTeam: &winner, Score: "6-4 6-4"}
+
+	// Decided by hand before the scoring match completed
+	decided, err := tournament.RecordMatchResult(state, tournament.MatchResult{MatchID: m.ID, WinnerTeamID: m.TeamBID, LoserTeamID: m.TeamAID})
+	if err != nil {
+		t.Fatalf("RecordMatchResult: %v", err)
+	}
+	if next, err := recordLinkedResult(decided, m.ID, scored); err != nil || next != decided {
+		t.Errorf("expected a decided match to be left alone, got %v", err)
+	}
+
+	// Released before the scoring match completed
+	released, err := tournament.LinkScoringMatch(state, m.ID, nil)
+	if err != nil {
+		t.Fatalf("LinkScoringMatch: %v", err)
+	}
+	if next, err := recordLinkedResult(released, m.ID, scored); err != nil || next != released {
+		t.Errorf("expected a released match to be left alone, got %v", err)
+	}
+
+	next, err := recordLinkedResult(state, m.ID, scored)
+	if err != nil {
+		t.Fatalf("recordLinkedResult: %v", err)
+	}
+	if played := next.RoundRobinMatches[0]; !played.Completed || *played.WinnerTeamID != m.TeamAID || played.SetsA != 2 {
+		t.Errorf("expected team A to win 2-0, got %+v", played)
+	}
+}
+
+func TestRecordScoredResultPreparesFinal(t *testing.T) {
+	state := newTestTournament(t)
+	for _, m := range state.RoundRobinMatches {
+		state, _ = playScored(t, state, m.ID, model.TeamA)
+	}
+	state, err := tournament.AdvanceToKnockout(state)
+	if err != nil {
+		t.Fatalf("AdvanceToKnockout: %v", err)
+	}
+
+	state, _ = playScored(t, state, state.KnockoutMatches[0].ID, model.TeamA)
+	if state.KnockoutMatches[2].TeamAID != uuid.Nil {
+		t.Error("final should wait for the second semifinal")
+	}
+	state, _ = playScored(t, state, state.KnockoutMatches[1].ID, model.TeamB)
+
+	final := state.KnockoutMatches[2]
+	if final.TeamAID != state.KnockoutMatches[0].TeamAID || final.TeamBID != state.KnockoutMatches[1].TeamBID {
+		t.Errorf("expected the semifinal winners in the final, got %s v %s", final.TeamAID, final.TeamBID)
+	}
+}
+
+func TestReleaseScoredMatch(t *testing.T) {
+	state := newTestTournament(t)
+	m := state.RoundRobinMatches[0]
+	state, scoringID := playScored(t, state, m.ID, model.TeamA)
+
+	released, err := releaseScoredMatch(state, m.ID, scoringID)
+	if err != nil {
+		t.Fatalf("releaseScoredMatch: %v", err)
+	}
+	match := released.RoundRobinMatches[0]
+	if match.Completed || match.WinnerTeamID != nil || match.ScoringMatchID != nil || match.GamesA != 0 {
+		t.Errorf("expected the result and link to be gone, got %+v", match)
+	}
+	if st := tournament.GetStandingByTeamID(released.Standings, m.TeamAID); st.Played != 0 || st.Points != 0 || st.GamesWon != 0 {
+		t.Errorf("expected the standings to drop the result, got %+v", *st)
+	}
+
+	// A scoring match that is no longer linked leaves the tournament alone
+	again, err := releaseScoredMatch(released, m.ID, scoringID)
+	if err != nil || again != released {
+		t.Errorf("expected no change, got %v", err)
+	}
+}
+
+func TestInvalidMatch(t *testing.T) {
+	// Validation errors keep their message and match ErrInvalidMatch
+	err := invalidMatch(fmt.Errorf("doubles match requires exactly 2 players per team"))
+	if !errors.Is(err, ErrInvalidMatch) || err.Error() != "doubles match requires exactly 2 players per team" {
+		t.Errorf("expected an invalid match error with the message kept, got %v", err)
+	}
+	if err := invalidMatch(fmt.Errorf("invalid venue: %w", repository.ErrNotFound)); !errors.Is(err, ErrInvalidMatch) || !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("expected a missing venue to be invalid, got %v", err)
+	}
+
+	// A failed lookup is not the request's fault
+	if err := invalidMatch(fmt.Errorf("invalid venue: %w", errors.New("connection refused"))); errors.Is(err, ErrInvalidMatch) {
+		t.Errorf("expected a failed lookup not to be invalid, got %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/model"
+	"github.com/saurabh22suman/oreo-tennis-scoring/backend/internal/tournament"
+)
+
+// newTestTournament returns a tournament of 4 teams in the round robin.
+func newTestTournament(t *testing.T) *tournament.TournamentState {
+	t.Helper()
+	players := make([]uuid.UUID, 8)
+	for i := range players {
+		players[i] = uuid.New()
+	}
+	state, err := tournament.NewTournament(uuid.New(), players)
+	if err != nil {
+		t.Fatalf("NewTournament: %v", err)
+	}
+	teams, err := tournament.GenerateRandomTeams(players, 1)
+	if err != nil {
+		t.Fatalf("GenerateRandomTeams: %v", err)
+	}
+	state, err = tournament.SetTeams(state, teams)
+	if err != nil {
+		t.Fatalf("SetTeams: %v", err)
+	}
+	return state
+}
+
+func TestTournamentResult(t *testing.T) {
+	state := newTestTournament(t)
+	m := state.RoundRobinMatches[0]
+
+	result, err := tournamentResult(state, m.ID, m.TeamBID)
+	if err != nil {
+		t.Fatalf("tournamentResult: %v", err)
+	}
+	if result.WinnerTeamID != m.TeamBID || result.LoserTeamID != m.TeamAID {
+		t.Errorf("expected B to beat A, got %+v", result)
+	}
+
+	if _, err := tournamentResult(state, m.ID, uuid.New()); !errors.Is(err, ErrInvalidTournament) {
+		t.Errorf("expected ErrInvalidTournament for a team not in the match, got %v", err)
+	}
+	if _, err := tournamentResult(state, uuid.New(), m.TeamAID); !errors.Is(err, tournament.ErrMatchNotFound) {
+		t.Errorf("expected ErrMatchNotFound, got %v", err)
+	}
+
+	state.RoundRobinMatches[0].Completed = true
+	if _, err := tournamentResult(state, m.ID, m.TeamAID); !errors.Is(err, tournament.ErrMatchCompleted) {
+		t.Errorf("expected ErrMatchCompleted, got %v", err)
+	}
+}
+
+func TestTournamentResultFinalNotReady(t *testing.T) {
+	state := newTestTournament(t)
+	for _, m := range state.RoundRobinMatches {
+		result, err := tournamentResult(state, m.ID, m.TeamAID)
+		if err != nil {
+			t.Fatalf("tournamentResult: %v", err)
+		}
+		if state, err = tournament.RecordMatchResult(state, result); err != nil {
+			t.Fatalf("RecordMatchResult: %v", err)
+		}
+	}
+	state, err := tournament.AdvanceToKnockout(state)
+	if err != nil {
+		t.Fatalf("AdvanceToKnockout: %v", err)
+	}
+
+	final := state.KnockoutMatches[len(state.KnockoutMatches)-1]
+	if _, err := tournamentResult(state, final.ID, state.Teams[0].ID); !errors.Is(err, ErrFinalNotReady) {
+		t.Errorf("expected ErrFinalNotReady, got %v", err)
+	}
+
+	view := tournamentView(state, 3)
+	last := view.Matches[len(view.Matches)-1]
+	if last.Stage != "final" || last.TeamAID != nil || last.TeamBID != nil {
+		t.Errorf("expected the final without teams, got %+v", last)
+	}
+	if view.Version != 3 || len(view.Teams) != 4 || len(view.Standings) != 4 {
+		t.Errorf("unexpected view: %+v", view)
+	}
+}
+
+func TestCheckTeamPlayers(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	players := []uuid.UUID{a, b, c, d}
+
+	valid := []tournament.Team{{Player1ID: a, Player2ID: b}, {Player1ID: c, Player2ID: d}}
+	if err := checkTeamPlayers(players, valid); err != nil {
+		t.Errorf("expected valid teams, got %v", err)
+	}
+
+	outsider := []tournament.Team{{Player1ID: a, Player2ID: b}, {Player1ID: c, Player2ID: uuid.New()}}
+	if err := checkTeamPlayers(players, outsider); !errors.Is(err, ErrInvalidTournament) {
+		t.Errorf("expected ErrInvalidTournament for an outsider, got %v", err)
+	}
+
+	missing := []tournament.Team{{Player1ID: a, Player2ID: b}}
+	if err := checkTeamPlayers(players, missing); !errors.Is(err, ErrInvalidTournament) {
+		t.Errorf("expected ErrInvalidTournament for a missing player, got %v", err)
+	}
+}
+
+func TestEngineError(t *testing.T) {
+	if err := engineError(tournament.ErrRoundRobinIncomplete); err != tournament.ErrRoundRobinIncomplete {
+		t.Errorf("expected state errors unchanged, got %v", err)
+	}
+
+	_, err := tournament.GenerateManualTeams([][2]uuid.UUID{{uuid.New(), uuid.New()}})
+	if err = engineError(err); !errors.Is(err, ErrInvalidTournament) {
+		t.Errorf("expected input errors as ErrInvalidTournament, got %v", err)
+	}
+}
+
+func TestScoreResult(t *testing.T) {
+	score, err := parseScore(model.MatchModeStandard, "6-4 3-6 7-5")
+	if err != nil {
+		t.Fatalf("parseScore: %v", err)
+	}
+
+	result := scoreResult(tournament.MatchResult{}, score, false, true)
+	if result.WinnerSets != 2 || result.LoserSets != 1 || result.WinnerGames != 16 || result.LoserGames != 15 {
+		t.Errorf("expected team A's score for the winner, got %+v", result)
+	}
+
+	short, err := parseScore(model.MatchModeShort, "1-2")
+	if err != nil {
+		t.Fatalf("parseScore: %v", err)
+	}
+	result = scoreResult(tournament.MatchResult{}, short, true, false)
+	if result.WinnerSets != 0 || result.LoserSets != 0 || result.WinnerGames != 2 || result.LoserGames != 1 {
+		t.Errorf("expected team B's games and no sets for a short-format win, got %+v", result)
+	}
+}
@@ -23,6 +23,21 @@ import (
 //   4. Completed: Winner declared
 // ═══════════════════════════════════════════════════════════════════════════
 
+// Errors for actions the tournament's current state does not allow. Any
+// other engine error means the input itself is invalid.
+var (
+	ErrTeamsAlreadySet      = errors.New("teams can only be set during setup stage")
+	ErrNotInRoundRobin      = errors.New("can only advance to knockout from round-robin stage")
+	ErrRoundRobinIncomplete = errors.New("cannot advance: round-robin not complete")
+	ErrNotInKnockout        = errors.New("not in knockout stage")
+	ErrSemifinalsIncomplete = errors.New("semifinals not complete")
+	ErrMatchCompleted       = errors.New("match already completed")
+	ErrMatchNotFound        = errors.New("match not found in tournament")
+	ErrMatchLinked          = errors.New("match already has a scoring match")
+	ErrNoResult             = errors.New("match has no result")
+	ErrResultLocked         = errors.New("later results depend on this match")
+)
+
 // NewTournament creates a new tournament in setup stage.
 //
 // Parameters:
@@ -78,7 +93,7 @@ func NewTournament(venueID uuid.UUID, playerIDs []uuid.UUID) (*TournamentState,
 func SetTeams(state *TournamentState, teams []Team) (*TournamentState, error) {
 	// Validate current stage
 	if state.Stage != StageSetup {
-		return nil, errors.New("teams can only be set during setup stage")
+		return nil, ErrTeamsAlreadySet
 	}
 
 	// Validate teams
@@ -127,11 +142,12 @@ func RecordMatchResult(state *TournamentState, result MatchResult) (*TournamentS
 		for i := range newState.RoundRobinMatches {
 			if newState.RoundRobinMatches[i].ID == result.MatchID {
 				if newState.RoundRobinMatches[i].Completed {
-					return nil, errors.New("match already completed")
+					return nil, ErrMatchCompleted
 				}
 
 				newState.RoundRobinMatches[i].WinnerTeamID = &result.WinnerTeamID
 				newState.RoundRobinMatches[i].Completed = true
+				setMatchScore(&newState.RoundRobinMatches[i], result)
 				matchFound = true
 				break
 			}
@@ -140,6 +156,7 @@ func RecordMatchResult(state *TournamentState, result MatchResult) (*TournamentS
 		if matchFound {
 			// Update standings
 			newState.Standings = UpdateStandingsWithResult(newState.Standings, result)
+			newState.Standings = RankStandings(newState.Standings, newState.Teams, newState.RoundRobinMatches)
 
 			// Check if round-robin complete
 			if IsRoundRobinComplete(newState.RoundRobinMatches) {
@@ -154,11 +171,12 @@ func RecordMatchResult(state *TournamentState, result MatchResult) (*TournamentS
 		for i := range newState.KnockoutMatches {
 			if newState.KnockoutMatches[i].ID == result.MatchID {
 				if newState.KnockoutMatches[i].Completed {
-					return nil, errors.New("match already completed")
+					return nil, ErrMatchCompleted
 				}
 
 				newState.KnockoutMatches[i].WinnerTeamID = &result.WinnerTeamID
 				newState.KnockoutMatches[i].Completed = true
+				setMatchScore(&newState.KnockoutMatches[i], result)
 				matchFound = true
 
 				// Check if it's the final
@@ -175,7 +193,7 @@ func RecordMatchResult(state *TournamentState, result MatchResult) (*TournamentS
 	}
 
 	if !matchFound {
-		return nil, errors.New("match not found in tournament")
+		return nil, ErrMatchNotFound
 	}
 
 	return newState, nil
@@ -194,19 +212,19 @@ func RecordMatchResult(state *TournamentState, result MatchResult) (*TournamentS
 func AdvanceToKnockout(state *TournamentState) (*TournamentState, error) {
 	// Validate current stage
 	if state.Stage != StageRoundRobin {
-		return nil, errors.New("can only advance to knockout from round-robin stage")
+		return nil, ErrNotInRoundRobin
 	}
 
 	// Validate round-robin complete
 	if !IsRoundRobinComplete(state.RoundRobinMatches) {
-		return nil, errors.New("cannot advance: round-robin not complete")
+		return nil, ErrRoundRobinIncomplete
 	}
 
 	// Create new state
 	newState := copyTournamentState(state)
 
-	// Calculate final rankings
-	newState.Standings = CalculateRankings(newState.Standings)
+	// Calculate final rankings, breaking ties with the round-robin results
+	newState.Standings = RankStandings(newState.Standings, newState.Teams, newState.RoundRobinMatches)
 
 	// Generate knockout matches
 	knockoutMatches, err := GenerateKnockoutMatches(newState.ID, newState.Standings)
@@ -232,11 +250,11 @@ func AdvanceToKnockout(state *TournamentState) (*TournamentState, error) {
 //   - Error if semifinals not complete
 func PrepareFinal(state *TournamentState) (*TournamentState, error) {
 	if state.Stage != StageKnockout {
-		return nil, errors.New("not in knockout stage")
+		return nil, ErrNotInKnockout
 	}
 
 	if !AreSemifinalsComplete(state.KnockoutMatches) {
-		return nil, errors.New("semifinals not complete")
+		return nil, ErrSemifinalsIncomplete
 	}
 
 	// Get semifinal winners
@@ -257,6 +275,120 @@ func PrepareFinal(state *TournamentState) (*TournamentState, error) {
 	return newState, nil
 }
 
+// LinkScoringMatch records the scoring match a tournament match is played
+// as. A nil scoringMatchID clears the link, e.g. when that match was
+// abandoned, so the tournament match can be started again.
+//
+// Returns:
+//   - Updated state with the link set
+//   - Error if the match is not found, already completed or already linked
+func LinkScoringMatch(state *TournamentState, matchID uuid.UUID, scoringMatchID *uuid.UUID) (*TournamentState, error) {
+	newState := copyTournamentState(state)
+
+	for _, matches := range [][]Match{newState.RoundRobinMatches, newState.KnockoutMatches} {
+		for i := range matches {
+			if matches[i].ID != matchID {
+				continue
+			}
+
+			if scoringMatchID != nil {
+				if matches[i].Completed {
+					return nil, ErrMatchCompleted
+				}
+				if matches[i].ScoringMatchID != nil {
+					return nil, ErrMatchLinked
+				}
+				if matches[i].TeamAID == uuid.Nil || matches[i].TeamBID == uuid.Nil {
+					return nil, errors.New("match teams not decided yet")
+				}
+			}
+
+			matches[i].ScoringMatchID = scoringMatchID
+			return newState, nil
+		}
+	}
+
+	return nil, ErrMatchNotFound
+}
+
+// UndoMatchResult takes back the result of a completed match, e.g. when
+// the scoring match it came from was deleted.
+//
+// Undo Rules:
+//   - Round robin: Standings are recalculated. After the knockout draw the
+//     tournament returns to the round robin, as long as no knockout match
+//     has been started
+//   - Semifinal: The final waits for its teams again, as long as it has
+//     not been started
+//   - Final: The tournament is no longer completed
+//
+// Returns:
+//   - Updated state without the result
+//   - ErrResultLocked if later matches depend on the result
+func UndoMatchResult(state *TournamentState, matchID uuid.UUID) (*TournamentState, error) {
+	newState := copyTournamentState(state)
+
+	for i := range newState.RoundRobinMatches {
+		match := &newState.RoundRobinMatches[i]
+		if match.ID != matchID {
+			continue
+		}
+		if !match.Completed {
+			return nil, ErrNoResult
+		}
+
+		// The knockout draw came from the standings: redraw it later
+		if newState.Stage == StageKnockout || newState.Stage == StageCompleted {
+			for _, knockout := range newState.KnockoutMatches {
+				if knockout.Completed || knockout.ScoringMatchID != nil {
+					return nil, ErrResultLocked
+				}
+			}
+			newState.KnockoutMatches = nil
+			newState.Stage = StageRoundRobin
+		}
+
+		*match = clearResult(*match)
+		newState.Standings = RecalculateStandings(newState.Teams, newState.RoundRobinMatches)
+		return newState, nil
+	}
+
+	for i := range newState.KnockoutMatches {
+		match := &newState.KnockoutMatches[i]
+		if match.ID != matchID {
+			continue
+		}
+		if !match.Completed {
+			return nil, ErrNoResult
+		}
+
+		if match.Stage == StageSemi {
+			for j := range newState.KnockoutMatches {
+				final := &newState.KnockoutMatches[j]
+				if final.Stage != StageFinal {
+					continue
+				}
+				if final.Completed || final.ScoringMatchID != nil {
+					return nil, ErrResultLocked
+				}
+				final.TeamAID = uuid.Nil
+				final.TeamBID = uuid.Nil
+			}
+		}
+
+		if match.Stage == StageFinal {
+			newState.Winner = nil
+			newState.Completed = false
+			newState.Stage = StageKnockout
+		}
+
+		*match = clearResult(*match)
+		return newState, nil
+	}
+
+	return nil, ErrMatchNotFound
+}
+
 // ─────────────────────────────────────────────────────────────────────────────
 // QUERY FUNCTIONS
 // ─────────────────────────────────────────────────────────────────────────────
@@ -295,6 +427,26 @@ func GetAllMatches(state *TournamentState) []Match {
 // HELPER FUNCTIONS
 // ─────────────────────────────────────────────────────────────────────────────
 
+// setMatchScore stores a result's score from the match's team A side.
+func setMatchScore(match *Match, result MatchResult) {
+	if result.WinnerTeamID == match.TeamAID {
+		match.SetsA, match.SetsB = result.WinnerSets, result.LoserSets
+		match.GamesA, match.GamesB = result.WinnerGames, result.LoserGames
+	} else {
+		match.SetsA, match.SetsB = result.LoserSets, result.WinnerSets
+		match.GamesA, match.GamesB = result.LoserGames, result.WinnerGames
+	}
+}
+
+// clearResult returns a match without its winner and score.
+func clearResult(match Match) Match {
+	match.WinnerTeamID = nil
+	match.SetsA, match.SetsB = 0, 0
+	match.GamesA, match.GamesB = 0, 0
+	match.Completed = false
+	return match
+}
+
 func copyTournamentState(state *TournamentState) *TournamentState {
 	newState := *state
 
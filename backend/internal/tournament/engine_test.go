@@ -115,6 +115,238 @@ func TestGenerateManualTeamsSamePlayerTwice(t *testing.T) {
 	}
 }
 
+// ─────────────────────────────────────────────────────────────────────────────
+// BALANCED TEAM GENERATION TESTS
+// ─────────────────────────────────────────────────────────────────────────────
+
+// strengthsOneToEight returns 8 players with strengths 1..8.
+func strengthsOneToEight() []PlayerStrength {
+	players := make([]PlayerStrength, 8)
+	for i := range players {
+		players[i] = PlayerStrength{PlayerID: uuid.New(), Strength: float64(i + 1)}
+	}
+	return players
+}
+
+func teamStrengths(players []PlayerStrength, teams []Team) []float64 {
+	strength := make(map[uuid.UUID]float64)
+	for _, p := range players {
+		strength[p.PlayerID] = p.Strength
+	}
+	sums := make([]float64, len(teams))
+	for i, team := range teams {
+		sums[i] = strength[team.Player1ID] + strength[team.Player2ID]
+	}
+	return sums
+}
+
+func sameTeam(teams []Team, a, b uuid.UUID) bool {
+	for _, team := range teams {
+		if (team.Player1ID == a && team.Player2ID == b) || (team.Player1ID == b && team.Player2ID == a) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestGenerateBalancedTeamsEvensStrength(t *testing.T) {
+	players := strengthsOneToEight()
+
+	teams, err := GenerateBalancedTeams(players, TeamConstraints{}, nil, 12345)
+	if err != nil {
+		t.Fatalf("Failed to generate teams: %v", err)
+	}
+
+	if len(teams) != 4 {
+		t.Fatalf("Expected 4 teams, got %d", len(teams))
+	}
+
+	// 1+8, 2+7, 3+6, 4+5 are all 9
+	for i, sum := range teamStrengths(players, teams) {
+		if sum != 9 {
+			t.Errorf("Team %d: expected strength 9, got %v", i+1, sum)
+		}
+	}
+
+	if err := ValidateTeams(teams); err != nil {
+		t.Errorf("Generated teams are invalid: %v", err)
+	}
+}
+
+func TestGenerateBalancedTeamsDeterministic(t *testing.T) {
+	players := []PlayerStrength{
+		{PlayerID: uuid.New(), Strength: 1500}, {PlayerID: uuid.New(), Strength: 1500},
+		{PlayerID: uuid.New(), Strength: 1500}, {PlayerID: uuid.New(), Strength: 1500},
+		{PlayerID: uuid.New(), Strength: 1500}, {PlayerID: uuid.New(), Strength: 1500},
+	}
+
+	teams1, _ := GenerateBalancedTeams(players, TeamConstraints{}, nil, 42)
+	teams2, _ := GenerateBalancedTeams(players, TeamConstraints{}, nil, 42)
+
+	// Same seed should produce same teams
+	for i := range teams1 {
+		if teams1[i].Player1ID != teams2[i].Player1ID || teams1[i].Player2ID != teams2[i].Player2ID {
+			t.Error("Same seed produced different teams")
+		}
+	}
+}
+
+func TestGenerateBalancedTeamsConstraints(t *testing.T) {
+	players := strengthsOneToEight()
+	strongest, second, weakest := players[7].PlayerID, players[6].PlayerID, players[0].PlayerID
+
+	constraints := TeamConstraints{
+		Together: [][2]uuid.UUID{{strongest, second}},
+		Apart:    [][2]uuid.UUID{{weakest, players[5].PlayerID}},
+	}
+
+	teams, err := GenerateBalancedTeams(players, constraints, nil, 7)
+	if err != nil {
+		t.Fatalf("Failed to generate teams: %v", err)
+	}
+
+	if !sameTeam(teams, strongest, second) {
+		t.Error("Players that must pair were split")
+	}
+	if sameTeam(teams, weakest, players[5].PlayerID) {
+		t.Error("Players to keep apart were paired")
+	}
+
+	// 7+8 is locked at 15, so the spread depends on the weakest free team.
+	// With 1+6 ruled out, 1+5 leaves it strongest.
+	if !sameTeam(teams, weakest, players[4].PlayerID) {
+		t.Error("Expected the weakest player to pair with the strongest free player")
+	}
+}
+
+func TestGenerateBalancedTeamsValidation(t *testing.T) {
+	players := strengthsOneToEight()
+	a, b, c := players[0].PlayerID, players[1].PlayerID, players[2].PlayerID
+
+	tests := []struct {
+		name        string
+		players     []PlayerStrength
+		constraints TeamConstraints
+	}{
+		{"too few players", players[:2], TeamConstraints{}},
+		{"odd player count", players[:5], TeamConstraints{}},
+		{"player twice", append([]PlayerStrength{players[0]}, players[:3]...), TeamConstraints{}},
+		{"unknown player", players, TeamConstraints{Together: [][2]uuid.UUID{{a, uuid.New()}}}},
+		{"pair with self", players, TeamConstraints{Apart: [][2]uuid.UUID{{a, a}}}},
+		{"two partners", players, TeamConstraints{Together: [][2]uuid.UUID{{a, b}, {a, c}}}},
+		{"together and apart", players, TeamConstraints{Together: [][2]uuid.UUID{{a, b}}, Apart: [][2]uuid.UUID{{b, a}}}},
+		{"cannot separate", players[:4], TeamConstraints{Apart: [][2]uuid.UUID{{a, b}, {a, c}, {a, players[3].PlayerID}}}},
+	}
+
+	for _, tt := range tests {
+		if _, err := GenerateBalancedTeams(tt.players, tt.constraints, nil, 123); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+}
+
+func TestPairingHistoryWindow(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	since := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	history := NewPairingHistory([]PastMatch{
+		{PlayedAt: since.AddDate(0, 0, -1), TeamA: []uuid.UUID{a, b}, TeamB: []uuid.UUID{c, d}},
+		{PlayedAt: since, TeamA: []uuid.UUID{a, c}, TeamB: []uuid.UUID{b, d}},
+		{PlayedAt: since.AddDate(0, 0, 7), TeamA: []uuid.UUID{c, a}, TeamB: []uuid.UUID{b}},
+	}, since)
+
+	if history.Partnered(a, b) != 0 {
+		t.Error("Matches before the window should not count")
+	}
+	if history.Partnered(c, a) != 2 || history.Partnered(b, d) != 1 {
+		t.Errorf("Expected partner counts 2 and 1, got %d and %d", history.Partnered(c, a), history.Partnered(b, d))
+	}
+	if history.Faced(b, a) != 2 || history.Faced(c, d) != 1 {
+		t.Errorf("Expected opponent counts 2 and 1, got %d and %d", history.Faced(b, a), history.Faced(c, d))
+	}
+
+	var none *PairingHistory
+	if none.Partnered(a, c) != 0 || none.Faced(a, b) != 0 {
+		t.Error("A nil history should count nothing")
+	}
+}
+
+func TestGenerateFreshTeamsAvoidsRepeatPartners(t *testing.T) {
+	players := make([]uuid.UUID, 8)
+	for i := range players {
+		players[i] = uuid.New()
+	}
+
+	// Last week: 1+2, 3+4, 5+6, 7+8
+	var past []PastMatch
+	for i := 0; i < len(players); i += 4 {
+		past = append(past, PastMatch{
+			PlayedAt: time.Now(),
+			TeamA:    []uuid.UUID{players[i], players[i+1]},
+			TeamB:    []uuid.UUID{players[i+2], players[i+3]},
+		})
+	}
+	history := NewPairingHistory(past, time.Time{})
+
+	for seed := int64(1); seed <= 10; seed++ {
+		teams, err := GenerateFreshTeams(players, history, seed)
+		if err != nil {
+			t.Fatalf("Failed to generate teams: %v", err)
+		}
+		for _, team := range teams {
+			if history.Partnered(team.Player1ID, team.Player2ID) > 0 {
+				t.Errorf("Seed %d: repeated a recent partnership", seed)
+			}
+		}
+
+		again, _ := GenerateFreshTeams(players, history, seed)
+		for i := range teams {
+			if teams[i].Player1ID != again[i].Player1ID || teams[i].Player2ID != again[i].Player2ID {
+				t.Errorf("Seed %d: same seed produced different teams", seed)
+			}
+		}
+	}
+}
+
+func TestGenerateFreshTeamsPairsRecentOpponents(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	// A+C and B+D partnered; A has also faced B in singles
+	history := NewPairingHistory([]PastMatch{
+		{PlayedAt: time.Now(), TeamA: []uuid.UUID{a, c}, TeamB: []uuid.UUID{b, d}},
+		{PlayedAt: time.Now(), TeamA: []uuid.UUID{a}, TeamB: []uuid.UUID{b}},
+	}, time.Time{})
+
+	teams, err := GenerateFreshTeams([]uuid.UUID{a, b, c, d}, history, 99)
+	if err != nil {
+		t.Fatalf("Failed to generate teams: %v", err)
+	}
+
+	// A+B and C+D spares the most rematches in the round robin
+	if !sameTeam(teams, a, b) || !sameTeam(teams, c, d) {
+		t.Error("Expected the most frequent recent opponents to be paired")
+	}
+}
+
+func TestGenerateBalancedTeamsHistoryBeforeBalance(t *testing.T) {
+	players := strengthsOneToEight()[:4]
+
+	// 1+4 is the even pairing, but they partnered last week
+	history := NewPairingHistory([]PastMatch{
+		{PlayedAt: time.Now(), TeamA: []uuid.UUID{players[0].PlayerID, players[3].PlayerID}, TeamB: []uuid.UUID{players[1].PlayerID, players[2].PlayerID}},
+	}, time.Time{})
+
+	teams, err := GenerateBalancedTeams(players, TeamConstraints{}, history, 5)
+	if err != nil {
+		t.Fatalf("Failed to generate teams: %v", err)
+	}
+
+	// Of the fresh pairings, 1+3 and 2+4 is the more even
+	if !sameTeam(teams, players[0].PlayerID, players[2].PlayerID) {
+		t.Error("Expected 1+3 and 2+4")
+	}
+}
+
 // ─────────────────────────────────────────────────────────────────────────────
 // ROUND ROBIN TESTS
 // ─────────────────────────────────────────────────────────────────────────────
@@ -258,6 +490,150 @@ func TestCalculateRankings(t *testing.T) {
 	}
 }
 
+// rankingFixture returns numbered teams and a completed round-robin match
+// for each result, given as winner and loser team indexes with the games
+// each won.
+func rankingFixture(n int, results [][4]int) ([]Team, []Match, []TeamStanding) {
+	teams := make([]Team, n)
+	for i := range teams {
+		teams[i] = Team{ID: uuid.New(), TeamNumber: i + 1}
+	}
+
+	standings := InitializeStandings(teams)
+	var matches []Match
+	for _, r := range results {
+		winner, loser := teams[r[0]].ID, teams[r[1]].ID
+		matches = append(matches, Match{
+			ID:           uuid.New(),
+			TeamAID:      winner,
+			TeamBID:      loser,
+			Stage:        StageRR,
+			WinnerTeamID: &winner,
+			GamesA:       r[2],
+			GamesB:       r[3],
+			Completed:    true,
+		})
+		standings = UpdateStandingsWithResult(standings, MatchResult{
+			WinnerTeamID: winner,
+			LoserTeamID:  loser,
+			WinnerGames:  r[2],
+			LoserGames:   r[3],
+		})
+	}
+	return teams, matches, standings
+}
+
+func TestUpdateStandingsWithScore(t *testing.T) {
+	teamA, teamB := uuid.New(), uuid.New()
+	standings := []TeamStanding{{TeamID: teamA}, {TeamID: teamB}}
+
+	updated := UpdateStandingsWithResult(standings, MatchResult{
+		WinnerTeamID: teamA,
+		LoserTeamID:  teamB,
+		WinnerSets:   2,
+		LoserSets:    1,
+		WinnerGames:  16,
+		LoserGames:   14,
+	})
+
+	winner := GetStandingByTeamID(updated, teamA)
+	loser := GetStandingByTeamID(updated, teamB)
+	if winner.SetsWon != 2 || winner.SetsLost != 1 || winner.GamesWon != 16 || winner.GamesLost != 14 {
+		t.Errorf("Winner sets/games incorrect: %+v", *winner)
+	}
+	if loser.SetsWon != 1 || loser.SetsLost != 2 || loser.GamesWon != 14 || loser.GamesLost != 16 {
+		t.Errorf("Loser sets/games incorrect: %+v", *loser)
+	}
+}
+
+func TestRankStandingsHeadToHead(t *testing.T) {
+	// Teams 1 and 2 both win 2, team 2 beat team 1 despite worse games
+	teams, matches, standings := rankingFixture(4, [][4]int{
+		{0, 2, 6, 0}, {0, 3, 6, 0}, {1, 0, 7, 6}, {1, 2, 6, 4}, {2, 3, 6, 4}, {3, 1, 6, 4},
+	})
+	ranked := RankStandings(standings, teams, matches)
+
+	if ranked[0].TeamID != teams[1].ID || ranked[1].TeamID != teams[0].ID {
+		t.Errorf("Team 2 should rank above team 1 on head-to-head")
+	}
+	if ranked[0].TieBreak != TieBreakHeadToHead || ranked[1].TieBreak != TieBreakHeadToHead {
+		t.Errorf("Expected head-to-head tie-break, got %q and %q", ranked[0].TieBreak, ranked[1].TieBreak)
+	}
+	if ranked[2].TeamID != teams[2].ID || ranked[3].TeamID != teams[3].ID {
+		t.Errorf("Team 3 should rank above team 4 on head-to-head")
+	}
+	for i, s := range ranked {
+		if s.Rank != i+1 {
+			t.Errorf("Expected rank %d, got %d", i+1, s.Rank)
+		}
+	}
+}
+
+func TestRankStandingsMiniLeague(t *testing.T) {
+	// Teams 1-3 beat each other in a circle and team 4: the mini-league
+	// is level, so games difference decides
+	teams, matches, standings := rankingFixture(4, [][4]int{
+		{0, 1, 6, 4}, {1, 2, 6, 0}, {2, 0, 6, 3}, {0, 3, 6, 1}, {1, 3, 6, 2}, {2, 3, 6, 0},
+	})
+	ranked := RankStandings(standings, teams, matches)
+
+	// Games difference: team 1 +4, team 2 +8, team 3 +3
+	want := []uuid.UUID{teams[1].ID, teams[0].ID, teams[2].ID, teams[3].ID}
+	for i, id := range want {
+		if ranked[i].TeamID != id {
+			t.Fatalf("Unexpected order at rank %d", i+1)
+		}
+	}
+	for _, s := range ranked[:3] {
+		if s.TieBreak != TieBreakGameDifference {
+			t.Errorf("Expected games difference tie-break, got %q", s.TieBreak)
+		}
+	}
+	if ranked[3].TieBreak != "" {
+		t.Errorf("Team not level on points should have no tie-break, got %q", ranked[3].TieBreak)
+	}
+}
+
+func TestRankStandingsMiniLeagueSplit(t *testing.T) {
+	// Teams 1-3 beat each other in a circle and team 4. Games difference
+	// puts team 1 (+10) ahead of teams 2 and 3 (+2 each), who are then
+	// separated by their own match
+	teams, matches, standings := rankingFixture(4, [][4]int{
+		{0, 1, 6, 0}, {1, 2, 6, 4}, {2, 0, 6, 4}, {0, 3, 6, 0}, {1, 3, 6, 0}, {2, 3, 6, 4},
+	})
+	ranked := RankStandings(standings, teams, matches)
+
+	for i, team := range teams {
+		if ranked[i].TeamID != team.ID {
+			t.Fatalf("Unexpected order at rank %d", i+1)
+		}
+	}
+	if ranked[0].TieBreak != TieBreakGameDifference {
+		t.Errorf("Expected games difference tie-break, got %q", ranked[0].TieBreak)
+	}
+	if ranked[1].TieBreak != TieBreakHeadToHead || ranked[2].TieBreak != TieBreakHeadToHead {
+		t.Errorf("Expected head-to-head tie-break, got %q and %q", ranked[1].TieBreak, ranked[2].TieBreak)
+	}
+}
+
+func TestRankStandingsDeterministic(t *testing.T) {
+	// No results: everyone is level on everything
+	teams, matches, standings := rankingFixture(4, nil)
+	reversed := []TeamStanding{standings[3], standings[2], standings[1], standings[0]}
+
+	for _, input := range [][]TeamStanding{standings, reversed} {
+		ranked := RankStandings(input, teams, matches)
+		for i, s := range ranked {
+			if s.TeamID != teams[i].ID {
+				t.Fatalf("Expected team number order, got team at %d out of place", i)
+			}
+			if s.TieBreak != TieBreakTeamNumber {
+				t.Errorf("Expected team number tie-break, got %q", s.TieBreak)
+			}
+		}
+	}
+}
+
 // ─────────────────────────────────────────────────────────────────────────────
 // KNOCKOUT TESTS
 // ─────────────────────────────────────────────────────────────────────────────
@@ -445,3 +821,146 @@ func TestFullTournamentFlow3Teams(t *testing.T) {
 		t.Error("Winner should be set")
 	}
 }
+
+// ─────────────────────────────────────────────────────────────────────────────
+// SCORING MATCH LINK TESTS
+// ─────────────────────────────────────────────────────────────────────────────
+
+// playRoundRobin starts a tournament of 8 players and lets team A win every
+// round-robin match.
+func playRoundRobin(t *testing.T) *TournamentState {
+	players := make([]uuid.UUID, 8)
+	for i := range players {
+		players[i] = uuid.New()
+	}
+
+	tournament, _ := NewTournament(uuid.New(), players)
+	teams, _ := GenerateRandomTeams(players, 12345)
+	tournament, _ = SetTeams(tournament, teams)
+
+	for _, match := range tournament.RoundRobinMatches {
+		var err error
+		tournament, err = RecordMatchResult(tournament, MatchResult{
+			MatchID:      match.ID,
+			WinnerTeamID: match.TeamAID,
+			LoserTeamID:  match.TeamBID,
+		})
+		if err != nil {
+			t.Fatalf("Failed to record match result: %v", err)
+		}
+	}
+	return tournament
+}
+
+func TestLinkScoringMatch(t *testing.T) {
+	tournament := playRoundRobin(t)
+	tournament, _ = AdvanceToKnockout(tournament)
+	semi := tournament.KnockoutMatches[0]
+	final := tournament.KnockoutMatches[2]
+	scoringID := uuid.New()
+
+	linked, err := LinkScoringMatch(tournament, semi.ID, &scoringID)
+	if err != nil {
+		t.Fatalf("Failed to link match: %v", err)
+	}
+	if linked.KnockoutMatches[0].ScoringMatchID == nil || *linked.KnockoutMatches[0].ScoringMatchID != scoringID {
+		t.Error("Semifinal should be linked to the scoring match")
+	}
+	if tournament.KnockoutMatches[0].ScoringMatchID != nil {
+		t.Error("Original state should be unchanged")
+	}
+
+	if _, err := LinkScoringMatch(linked, semi.ID, &scoringID); err != ErrMatchLinked {
+		t.Errorf("Expected ErrMatchLinked, got %v", err)
+	}
+	if _, err := LinkScoringMatch(linked, final.ID, &scoringID); err == nil {
+		t.Error("Should not link the final before its teams are known")
+	}
+	if _, err := LinkScoringMatch(linked, tournament.RoundRobinMatches[0].ID, &scoringID); err != ErrMatchCompleted {
+		t.Errorf("Expected ErrMatchCompleted, got %v", err)
+	}
+
+	cleared, err := LinkScoringMatch(linked, semi.ID, nil)
+	if err != nil {
+		t.Fatalf("Failed to clear link: %v", err)
+	}
+	if cleared.KnockoutMatches[0].ScoringMatchID != nil {
+		t.Error("Link should be cleared")
+	}
+}
+
+func TestUndoRoundRobinResult(t *testing.T) {
+	tournament := playRoundRobin(t)
+	match := tournament.RoundRobinMatches[0]
+
+	undone, err := UndoMatchResult(tournament, match.ID)
+	if err != nil {
+		t.Fatalf("Failed to undo result: %v", err)
+	}
+	if undone.RoundRobinMatches[0].Completed || undone.RoundRobinMatches[0].WinnerTeamID != nil {
+		t.Error("Match should have no result")
+	}
+	winner := GetStandingByTeamID(undone.Standings, match.TeamAID)
+	if winner.Played != 2 || winner.Won != GetStandingByTeamID(tournament.Standings, match.TeamAID).Won-1 {
+		t.Errorf("Winner's standing should lose the match, got %+v", *winner)
+	}
+	if _, err := UndoMatchResult(undone, match.ID); err != ErrNoResult {
+		t.Errorf("Expected ErrNoResult, got %v", err)
+	}
+
+	// After the draw, the knockout is discarded until the match is replayed
+	knockout, _ := AdvanceToKnockout(tournament)
+	undone, err = UndoMatchResult(knockout, match.ID)
+	if err != nil {
+		t.Fatalf("Failed to undo result after draw: %v", err)
+	}
+	if undone.Stage != StageRoundRobin || undone.KnockoutMatches != nil {
+		t.Errorf("Expected round robin without knockout, got %s with %d matches", undone.Stage, len(undone.KnockoutMatches))
+	}
+
+	scoringID := uuid.New()
+	started, _ := LinkScoringMatch(knockout, knockout.KnockoutMatches[0].ID, &scoringID)
+	if _, err := UndoMatchResult(started, match.ID); err != ErrResultLocked {
+		t.Errorf("Expected ErrResultLocked once the knockout started, got %v", err)
+	}
+}
+
+func TestUndoKnockoutResults(t *testing.T) {
+	tournament := playRoundRobin(t)
+	tournament, _ = AdvanceToKnockout(tournament)
+	for _, semi := range tournament.KnockoutMatches[:2] {
+		tournament, _ = RecordMatchResult(tournament, MatchResult{
+			MatchID:      semi.ID,
+			WinnerTeamID: semi.TeamAID,
+			LoserTeamID:  semi.TeamBID,
+		})
+	}
+	tournament, _ = PrepareFinal(tournament)
+	semi := tournament.KnockoutMatches[0]
+	final := tournament.KnockoutMatches[2]
+
+	undone, err := UndoMatchResult(tournament, semi.ID)
+	if err != nil {
+		t.Fatalf("Failed to undo semifinal: %v", err)
+	}
+	if undone.KnockoutMatches[2].TeamAID != uuid.Nil || undone.KnockoutMatches[2].TeamBID != uuid.Nil {
+		t.Error("Final should wait for its teams again")
+	}
+
+	completed, _ := RecordMatchResult(tournament, MatchResult{
+		MatchID:      final.ID,
+		WinnerTeamID: final.TeamAID,
+		LoserTeamID:  final.TeamBID,
+	})
+	if _, err := UndoMatchResult(completed, semi.ID); err != ErrResultLocked {
+		t.Errorf("Expected ErrResultLocked once the final is played, got %v", err)
+	}
+
+	reopened, err := UndoMatchResult(completed, final.ID)
+	if err != nil {
+		t.Fatalf("Failed to undo final: %v", err)
+	}
+	if reopened.Completed || reopened.Winner != nil || reopened.Stage != StageKnockout {
+		t.Errorf("Tournament should be back in the knockout, got %s", reopened.Stage)
+	}
+}
@@ -139,7 +139,7 @@ func GetSemifinalWinners(matches []Match) (uuid.UUID, uuid.UUID, error) {
 
 	for _, match := range semis {
 		if !match.Completed {
-			return uuid.Nil, uuid.Nil, errors.New("semifinals not complete")
+			return uuid.Nil, uuid.Nil, ErrSemifinalsIncomplete
 		}
 
 		if match.WinnerTeamID == nil {
@@ -0,0 +1,85 @@
+package tournament
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// TOURNAMENT ENGINE - PAIRING HISTORY
+// ═══════════════════════════════════════════════════════════════════════════
+// Who recently played with and against whom, so team generation can
+// avoid handing players the same partner as last time.
+//
+// In a round robin every player faces everyone except their partner, so
+// the only way to spare two recent opponents a rematch is to pair them.
+// Team generation therefore:
+//   1. Avoids pairing recent partners again
+//   2. Prefers pairing recent opponents
+//
+// The caller decides the look-back window and loads the matches; this
+// file only counts them.
+// ═══════════════════════════════════════════════════════════════════════════
+
+// PastMatch is a doubles match played before the tournament.
+type PastMatch struct {
+	// PlayedAt: When the match started
+	PlayedAt time.Time
+
+	// TeamA, TeamB: Players on each side (one or two each)
+	TeamA []uuid.UUID
+	TeamB []uuid.UUID
+}
+
+// PairingHistory counts how often players partnered and faced each other
+// within a look-back window. A nil history counts nothing.
+type PairingHistory struct {
+	partners  map[[2]uuid.UUID]int
+	opponents map[[2]uuid.UUID]int
+}
+
+// NewPairingHistory counts the partners and opponents in past matches
+// played at or after since.
+func NewPairingHistory(matches []PastMatch, since time.Time) *PairingHistory {
+	h := &PairingHistory{
+		partners:  make(map[[2]uuid.UUID]int),
+		opponents: make(map[[2]uuid.UUID]int),
+	}
+
+	for _, m := range matches {
+		if m.PlayedAt.Before(since) {
+			continue
+		}
+		for _, side := range [][]uuid.UUID{m.TeamA, m.TeamB} {
+			for i := 0; i < len(side); i++ {
+				for j := i + 1; j < len(side); j++ {
+					h.partners[sortedPair(side[i], side[j])]++
+				}
+			}
+		}
+		for _, a := range m.TeamA {
+			for _, b := range m.TeamB {
+				h.opponents[sortedPair(a, b)]++
+			}
+		}
+	}
+
+	return h
+}
+
+// Partnered returns how many times two players played on the same side.
+func (h *PairingHistory) Partnered(a, b uuid.UUID) int {
+	if h == nil {
+		return 0
+	}
+	return h.partners[sortedPair(a, b)]
+}
+
+// Faced returns how many times two players played on opposite sides.
+func (h *PairingHistory) Faced(a, b uuid.UUID) int {
+	if h == nil {
+		return 0
+	}
+	return h.opponents[sortedPair(a, b)]
+}
@@ -17,6 +17,7 @@ import (
 //   - won: Matches won
 //   - lost: Matches lost
 //   - points: Tournament points
+//   - sets and games won and lost (for tie-breaks)
 //
 // Points System (Section 5.2):
 //   - Win → 1 point
@@ -26,7 +27,8 @@ import (
 // Ranking Rules (Section 5.3):
 //   1. Points (descending)
 //   2. Head-to-head result
-//   3. Games difference (optional)
+//   3. Games difference
+//   4. Team number (so ties never depend on ordering)
 // ═══════════════════════════════════════════════════════════════════════════
 
 // InitializeStandings creates initial standings for all teams.
@@ -59,6 +61,7 @@ func InitializeStandings(teams []Team) []TeamStanding {
 // Updates:
 //   - Winner: played +1, won +1, points +1
 //   - Loser: played +1, lost +1, points +0
+//   - Both: sets and games from the result's score
 //
 // Returns updated standings (immutable operation).
 func UpdateStandingsWithResult(standings []TeamStanding, result MatchResult) []TeamStanding {
@@ -73,25 +76,64 @@ func UpdateStandingsWithResult(standings []TeamStanding, result MatchResult) []T
 			updated[i].Played++
 			updated[i].Won++
 			updated[i].Points++
+			updated[i].SetsWon += result.WinnerSets
+			updated[i].SetsLost += result.LoserSets
+			updated[i].GamesWon += result.WinnerGames
+			updated[i].GamesLost += result.LoserGames
 		} else if updated[i].TeamID == result.LoserTeamID {
 			// Loser gets: +1 played, +1 lost, +0 points
 			updated[i].Played++
 			updated[i].Lost++
+			updated[i].SetsWon += result.LoserSets
+			updated[i].SetsLost += result.WinnerSets
+			updated[i].GamesWon += result.LoserGames
+			updated[i].GamesLost += result.WinnerGames
 		}
 	}
 
 	return updated
 }
 
-// CalculateRankings sorts standings and assigns ranks.
-//
-// Ranking Rules (per spec Section 5.3):
-//  1. Points (descending)
-//  2. If tied, head-to-head result (requires match history)
-//  3. If still tied, games difference (optional, not implemented)
+// RecalculateStandings rebuilds and ranks standings from the completed
+// round-robin matches, e.g. after a result was taken back.
+func RecalculateStandings(teams []Team, matches []Match) []TeamStanding {
+	standings := InitializeStandings(teams)
+
+	for _, match := range matches {
+		if match.Stage != StageRR || !match.Completed || match.WinnerTeamID == nil {
+			continue
+		}
+
+		result := MatchResult{
+			MatchID:      match.ID,
+			WinnerTeamID: match.TeamAID,
+			LoserTeamID:  match.TeamBID,
+			WinnerSets:   match.SetsA,
+			LoserSets:    match.SetsB,
+			WinnerGames:  match.GamesA,
+			LoserGames:   match.GamesB,
+		}
+		if *match.WinnerTeamID == match.TeamBID {
+			result = MatchResult{
+				MatchID:      match.ID,
+				WinnerTeamID: match.TeamBID,
+				LoserTeamID:  match.TeamAID,
+				WinnerSets:   match.SetsB,
+				LoserSets:    match.SetsA,
+				WinnerGames:  match.GamesB,
+				LoserGames:   match.GamesA,
+			}
+		}
+		standings = UpdateStandingsWithResult(standings, result)
+	}
+
+	return RankStandings(standings, teams, matches)
+}
+
+// CalculateRankings sorts standings by points and assigns ranks.
 //
-// For simplicity in Phase 1: Rank by points only.
-// Head-to-head can be added later by passing match results.
+// Teams level on points keep their order, so standings already ranked by
+// RankStandings keep their tie-breaks. Use RankStandings to break ties.
 //
 // Returns standings sorted by rank.
 func CalculateRankings(standings []TeamStanding) []TeamStanding {
@@ -100,7 +142,7 @@ func CalculateRankings(standings []TeamStanding) []TeamStanding {
 	copy(ranked, standings)
 
 	// Sort by points (descending)
-	sort.Slice(ranked, func(i, j int) bool {
+	sort.SliceStable(ranked, func(i, j int) bool {
 		return ranked[i].Points > ranked[j].Points
 	})
 
@@ -112,6 +154,122 @@ func CalculateRankings(standings []TeamStanding) []TeamStanding {
 	return ranked
 }
 
+// RankStandings sorts standings and assigns ranks, breaking ties.
+//
+// Ranking Rules (per spec Section 5.3):
+//  1. Points (descending)
+//  2. Head-to-head: wins in round-robin matches between the level teams.
+//     With 3 or more level teams this is a mini-league among them
+//  3. Games difference (descending)
+//  4. Team number (ascending)
+//
+// When a rule separates some but not all of the level teams, the teams
+// still level start again from head-to-head among themselves. Each team
+// level on points gets the last rule that decided its place as TieBreak.
+//
+// Returns standings sorted by rank.
+func RankStandings(standings []TeamStanding, teams []Team, matches []Match) []TeamStanding {
+	ranked := make([]TeamStanding, len(standings))
+	copy(ranked, standings)
+
+	numbers := make(map[uuid.UUID]int, len(teams))
+	for _, team := range teams {
+		numbers[team.ID] = team.TeamNumber
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Points > ranked[j].Points
+	})
+
+	for start := 0; start < len(ranked); {
+		end := start + 1
+		for end < len(ranked) && ranked[end].Points == ranked[start].Points {
+			end++
+		}
+		for i := start; i < end; i++ {
+			ranked[i].TieBreak = ""
+		}
+		breakTie(ranked[start:end], numbers, matches)
+		start = end
+	}
+
+	for i := range ranked {
+		ranked[i].Rank = i + 1
+	}
+
+	return ranked
+}
+
+// breakTie orders teams level on points, in place.
+func breakTie(level []TeamStanding, numbers map[uuid.UUID]int, matches []Match) {
+	if len(level) < 2 {
+		return
+	}
+
+	wins := headToHeadWins(level, matches)
+	headToHead := func(s TeamStanding) int { return wins[s.TeamID] }
+	if separate(level, headToHead, TieBreakHeadToHead, numbers, matches) {
+		return
+	}
+
+	gameDifference := func(s TeamStanding) int { return s.GamesWon - s.GamesLost }
+	if separate(level, gameDifference, TieBreakGameDifference, numbers, matches) {
+		return
+	}
+
+	sort.SliceStable(level, func(i, j int) bool {
+		return numbers[level[i].TeamID] < numbers[level[j].TeamID]
+	})
+	for i := range level {
+		level[i].TieBreak = TieBreakTeamNumber
+	}
+}
+
+// separate orders level teams by a key, highest first. If the key tells
+// any of them apart, the teams get the tie-break and those still level are
+// broken again among themselves. Returns false if all stay level.
+func separate(level []TeamStanding, key func(TeamStanding) int, tieBreak TieBreak, numbers map[uuid.UUID]int, matches []Match) bool {
+	sort.SliceStable(level, func(i, j int) bool {
+		return key(level[i]) > key(level[j])
+	})
+	if key(level[0]) == key(level[len(level)-1]) {
+		return false
+	}
+
+	for i := range level {
+		level[i].TieBreak = tieBreak
+	}
+	for start := 0; start < len(level); {
+		end := start + 1
+		for end < len(level) && key(level[end]) == key(level[start]) {
+			end++
+		}
+		breakTie(level[start:end], numbers, matches)
+		start = end
+	}
+	return true
+}
+
+// headToHeadWins counts each team's wins in completed round-robin matches
+// against the other given teams.
+func headToHeadWins(level []TeamStanding, matches []Match) map[uuid.UUID]int {
+	inGroup := make(map[uuid.UUID]bool, len(level))
+	for _, s := range level {
+		inGroup[s.TeamID] = true
+	}
+
+	wins := make(map[uuid.UUID]int, len(level))
+	for _, match := range matches {
+		if match.Stage != StageRR || !match.Completed || match.WinnerTeamID == nil {
+			continue
+		}
+		if inGroup[match.TeamAID] && inGroup[match.TeamBID] {
+			wins[*match.WinnerTeamID]++
+		}
+	}
+	return wins
+}
+
 // GetStandingByTeamID retrieves a team's standing.
 func GetStandingByTeamID(standings []TeamStanding, teamID uuid.UUID) *TeamStanding {
 	for i := range standings {
@@ -0,0 +1,312 @@
+package tournament
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/google/uuid"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// TOURNAMENT ENGINE - BALANCED TEAM GENERATION
+// ═══════════════════════════════════════════════════════════════════════════
+// Pairs players so that the teams are as even as possible. A team's
+// strength is the sum of its players' strengths. With a pairing history
+// it also avoids recent partners (see pairing_history.go).
+//
+// Algorithm:
+//   1. Lock the "together" pairs as teams
+//   2. Pair the remaining players from a seeded shuffle
+//   3. Swap partners between two teams while that improves the pairing
+//   4. Repeat from several shuffles and keep the best pairing
+//
+// A pairing is better if it has (in order):
+//   1. Fewer teams breaking an "apart" constraint
+//   2. Fewer repeat partners from the history
+//   3. A smaller spread (strongest team minus weakest team)
+//   4. Fewer repeat opponents from the history
+//   5. A smaller variance of team strength
+//
+// Same players, strengths, constraints and seed → same teams.
+// ═══════════════════════════════════════════════════════════════════════════
+
+// balancedRestarts is how many shuffles the search starts from.
+const balancedRestarts = 20
+
+// balanceCost scores a pairing; lower is better.
+type balanceCost struct {
+	violations      int
+	repeatPartners  int
+	spread          float64
+	repeatOpponents int
+	variance        float64
+}
+
+func (c balanceCost) less(other balanceCost) bool {
+	if c.violations != other.violations {
+		return c.violations < other.violations
+	}
+	if c.repeatPartners != other.repeatPartners {
+		return c.repeatPartners < other.repeatPartners
+	}
+	if c.spread != other.spread {
+		return c.spread < other.spread
+	}
+	if c.repeatOpponents != other.repeatOpponents {
+		return c.repeatOpponents < other.repeatOpponents
+	}
+	return c.variance < other.variance
+}
+
+// teamBalancer holds what the search needs to score a pairing.
+type teamBalancer struct {
+	strength map[uuid.UUID]float64
+	apart    map[[2]uuid.UUID]bool
+	history  *PairingHistory
+
+	// faced: Past meetings between any two players in the draw. A round
+	// robin replays all of them except those between partners.
+	faced int
+}
+
+// GenerateBalancedTeams creates doubles teams with team strengths as even
+// as possible.
+//
+// Parameters:
+//   - players: All players to be assigned to teams, with their strength
+//   - constraints: Pairs that must play together or must be kept apart
+//   - history: Recent partners and opponents to avoid (nil for none)
+//   - seed: Random seed for reproducibility (use time.Now().UnixNano() for random)
+//
+// Returns:
+//   - Slice of Team structs, in seeded random order
+//   - Error if player count is odd or less than 4, or the constraints
+//     are invalid or cannot all be met
+func GenerateBalancedTeams(players []PlayerStrength, constraints TeamConstraints, history *PairingHistory, seed int64) ([]Team, error) {
+	// Validation
+	if len(players) < 4 {
+		return nil, errors.New("minimum 4 players required for tournament")
+	}
+
+	if len(players)%2 != 0 {
+		return nil, errors.New("player count must be even for doubles")
+	}
+
+	b := &teamBalancer{
+		strength: make(map[uuid.UUID]float64, len(players)),
+		apart:    make(map[[2]uuid.UUID]bool),
+		history:  history,
+	}
+	for _, p := range players {
+		if p.PlayerID == uuid.Nil {
+			return nil, errors.New("invalid player ID")
+		}
+		if _, ok := b.strength[p.PlayerID]; ok {
+			return nil, fmt.Errorf("player %s appears more than once", p.PlayerID)
+		}
+		b.strength[p.PlayerID] = p.Strength
+	}
+	for i := range players {
+		for j := i + 1; j < len(players); j++ {
+			b.faced += history.Faced(players[i].PlayerID, players[j].PlayerID)
+		}
+	}
+
+	locked, err := b.lockTogether(constraints.Together)
+	if err != nil {
+		return nil, err
+	}
+	for _, pair := range constraints.Apart {
+		if err := b.checkPair(pair); err != nil {
+			return nil, err
+		}
+		b.apart[sortedPair(pair[0], pair[1])] = true
+	}
+	for _, team := range locked {
+		if b.apart[sortedPair(team[0], team[1])] {
+			return nil, fmt.Errorf("players %s and %s cannot be both together and apart", team[0], team[1])
+		}
+	}
+
+	// Players not in a "together" pair, in input order
+	paired := make(map[uuid.UUID]bool)
+	for _, team := range locked {
+		paired[team[0]] = true
+		paired[team[1]] = true
+	}
+	var free []uuid.UUID
+	for _, p := range players {
+		if !paired[p.PlayerID] {
+			free = append(free, p.PlayerID)
+		}
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+
+	var best [][2]uuid.UUID
+	var bestCost balanceCost
+	for restart := 0; restart < balancedRestarts; restart++ {
+		shuffled := make([]uuid.UUID, len(free))
+		copy(shuffled, free)
+		for i := len(shuffled) - 1; i > 0; i-- {
+			j := rng.Intn(i + 1)
+			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+		}
+
+		pairs := make([][2]uuid.UUID, 0, len(players)/2)
+		pairs = append(pairs, locked...)
+		for i := 0; i < len(shuffled); i += 2 {
+			pairs = append(pairs, [2]uuid.UUID{shuffled[i], shuffled[i+1]})
+		}
+
+		cost := b.improve(pairs, len(locked))
+		if best == nil || cost.less(bestCost) {
+			best, bestCost = pairs, cost
+		}
+	}
+
+	if bestCost.violations > 0 {
+		return nil, errors.New("players to keep apart cannot all be separated")
+	}
+
+	// Number teams in seeded random order so locked pairs are not always first
+	for i := len(best) - 1; i > 0; i-- {
+		j := rng.Intn(i + 1)
+		best[i], best[j] = best[j], best[i]
+	}
+
+	teams := make([]Team, len(best))
+	for i, pair := range best {
+		teams[i] = Team{
+			ID:         uuid.New(),
+			Player1ID:  pair[0],
+			Player2ID:  pair[1],
+			TeamNumber: i + 1,
+		}
+	}
+
+	return teams, nil
+}
+
+// GenerateFreshTeams creates random doubles teams that avoid recent
+// partners. It is ModeRandom with a history: every player counts as
+// equally strong, so the seed decides among pairings that repeat as few
+// partners (then opponents) as possible.
+//
+// Parameters:
+//   - playerIDs: All players to be assigned to teams
+//   - history: Recent partners and opponents to avoid (nil for none)
+//   - seed: Random seed for reproducibility (use time.Now().UnixNano() for random)
+//
+// Returns:
+//   - Slice of Team structs
+//   - Error if player count is odd or less than 4
+func GenerateFreshTeams(playerIDs []uuid.UUID, history *PairingHistory, seed int64) ([]Team, error) {
+	players := make([]PlayerStrength, len(playerIDs))
+	for i, id := range playerIDs {
+		players[i] = PlayerStrength{PlayerID: id}
+	}
+	return GenerateBalancedTeams(players, TeamConstraints{}, history, seed)
+}
+
+// lockTogether validates the "together" pairs, which become fixed teams.
+func (b *teamBalancer) lockTogether(together [][2]uuid.UUID) ([][2]uuid.UUID, error) {
+	seen := make(map[uuid.UUID]bool)
+	locked := make([][2]uuid.UUID, 0, len(together))
+	for _, pair := range together {
+		if err := b.checkPair(pair); err != nil {
+			return nil, err
+		}
+		for _, id := range pair {
+			if seen[id] {
+				return nil, fmt.Errorf("player %s must pair with more than one player", id)
+			}
+			seen[id] = true
+		}
+		locked = append(locked, pair)
+	}
+	return locked, nil
+}
+
+// checkPair ensures a constraint names two different players in the draw.
+func (b *teamBalancer) checkPair(pair [2]uuid.UUID) error {
+	if pair[0] == pair[1] {
+		return fmt.Errorf("constraint names player %s twice", pair[0])
+	}
+	for _, id := range pair {
+		if _, ok := b.strength[id]; !ok {
+			return fmt.Errorf("constraint names player %s who is not in the tournament", id)
+		}
+	}
+	return nil
+}
+
+// improve swaps partners between two unlocked teams while any swap lowers
+// the cost, then returns the final cost. The first `locked` pairs are
+// never changed.
+func (b *teamBalancer) improve(pairs [][2]uuid.UUID, locked int) balanceCost {
+	cost := b.cost(pairs)
+	for improved := true; improved; {
+		improved = false
+		for i := locked; i < len(pairs); i++ {
+			for j := i + 1; j < len(pairs); j++ {
+				a1, a2 := pairs[i][0], pairs[i][1]
+				b1, b2 := pairs[j][0], pairs[j][1]
+				for _, swap := range [2][2][2]uuid.UUID{
+					{{a1, b1}, {a2, b2}},
+					{{a1, b2}, {a2, b1}},
+				} {
+					oldI, oldJ := pairs[i], pairs[j]
+					pairs[i], pairs[j] = swap[0], swap[1]
+					if next := b.cost(pairs); next.less(cost) {
+						cost = next
+						improved = true
+						break
+					}
+					pairs[i], pairs[j] = oldI, oldJ
+				}
+			}
+		}
+	}
+	return cost
+}
+
+// cost scores a pairing.
+func (b *teamBalancer) cost(pairs [][2]uuid.UUID) balanceCost {
+	c := balanceCost{repeatOpponents: b.faced}
+	sums := make([]float64, len(pairs))
+	var total float64
+	for i, pair := range pairs {
+		if b.apart[sortedPair(pair[0], pair[1])] {
+			c.violations++
+		}
+		c.repeatPartners += b.history.Partnered(pair[0], pair[1])
+		c.repeatOpponents -= b.history.Faced(pair[0], pair[1])
+		sums[i] = b.strength[pair[0]] + b.strength[pair[1]]
+		total += sums[i]
+	}
+
+	weakest, strongest := sums[0], sums[0]
+	mean := total / float64(len(sums))
+	for _, sum := range sums {
+		if sum < weakest {
+			weakest = sum
+		}
+		if sum > strongest {
+			strongest = sum
+		}
+		c.variance += (sum - mean) * (sum - mean)
+	}
+	c.spread = strongest - weakest
+	c.variance /= float64(len(sums))
+	return c
+}
+
+// sortedPair orders two player IDs so a pair has one map key.
+func sortedPair(a, b uuid.UUID) [2]uuid.UUID {
+	if b.String() < a.String() {
+		a, b = b, a
+	}
+	return [2]uuid.UUID{a, b}
+}
@@ -14,9 +14,10 @@ import (
 // Source of Truth: OTS_Tournament_Spec.md Section 3
 // This file implements team creation for doubles tournaments.
 //
-// Two modes supported:
+// Three modes supported:
 //   1. Random: Shuffle players and pair sequentially
 //   2. Manual: User provides explicit pairs
+//   3. Balanced: Pair players by strength (see team_balancer.go)
 // ═══════════════════════════════════════════════════════════════════════════
 
 // GenerateRandomTeams creates doubles teams by shuffling and pairing players.
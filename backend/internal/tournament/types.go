@@ -54,6 +54,10 @@ type TournamentState struct {
 	// Teams: Generated doubles teams (pairs of players)
 	Teams []Team
 
+	// TeamSeed: Seed random or balanced teams were drawn with, so the draw
+	// can be repeated (nil for manual teams)
+	TeamSeed *int64
+
 	// ─────────────────────────────────────────────────────────────────────
 	// TOURNAMENT STAGE
 	// ─────────────────────────────────────────────────────────────────────
@@ -148,6 +152,13 @@ type Match struct {
 	// WinnerTeamID: Which team won this match
 	WinnerTeamID *uuid.UUID
 
+	// SetsA, SetsB, GamesA, GamesB: Sets and games each team won
+	// (0 if the result was recorded without a score)
+	SetsA  int
+	SetsB  int
+	GamesA int
+	GamesB int
+
 	// Completed: True when match is finished
 	Completed bool
 }
@@ -175,8 +186,32 @@ type TeamStanding struct {
 
 	// Points: Tournament points (1 per win, 0 per loss)
 	Points int
+
+	// SetsWon, SetsLost, GamesWon, GamesLost: Totals over the round robin
+	SetsWon   int
+	SetsLost  int
+	GamesWon  int
+	GamesLost int
+
+	// TieBreak: What decided the team's place among teams level on points
+	// (empty if not level)
+	TieBreak TieBreak
 }
 
+// TieBreak is the rule that separated teams level on points.
+type TieBreak string
+
+const (
+	// TieBreakHeadToHead: Wins in matches between the level teams
+	TieBreakHeadToHead TieBreak = "head_to_head"
+
+	// TieBreakGameDifference: Games won minus games lost
+	TieBreakGameDifference TieBreak = "game_difference"
+
+	// TieBreakTeamNumber: Still level, so the lower team number goes first
+	TieBreakTeamNumber TieBreak = "team_number"
+)
+
 // MatchResult represents the outcome of a completed match.
 // This is what the tournament engine receives from the scoring engine.
 type MatchResult struct {
@@ -188,6 +223,12 @@ type MatchResult struct {
 
 	// LoserTeamID: Which team lost
 	LoserTeamID uuid.UUID
+
+	// WinnerSets, LoserSets, WinnerGames, LoserGames: The score, if known
+	WinnerSets  int
+	LoserSets   int
+	WinnerGames int
+	LoserGames  int
 }
 
 // TeamCreationMode specifies how teams are generated.
@@ -199,4 +240,26 @@ const (
 
 	// ModeManual: User manually assigns players to teams
 	ModeManual TeamCreationMode = "manual"
+
+	// ModeBalanced: Pair players so team strengths are as even as possible
+	ModeBalanced TeamCreationMode = "balanced"
 )
+
+// PlayerStrength is a player's strength for balanced team generation.
+type PlayerStrength struct {
+	// PlayerID: Player identifier
+	PlayerID uuid.UUID
+
+	// Strength: Any measure where higher is stronger, e.g. a doubles
+	// rating or a win rate. All players must use the same measure.
+	Strength float64
+}
+
+// TeamConstraints restricts which players balanced generation may pair.
+type TeamConstraints struct {
+	// Together: Pairs that must play as a team
+	Together [][2]uuid.UUID
+
+	// Apart: Pairs that must not play as a team
+	Apart [][2]uuid.UUID
+}
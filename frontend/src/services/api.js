@@ -139,21 +139,29 @@ export async function getVenueTendencies(venueId, dateFilter = {}) {
 // MATCHES
 // ═══════════════════════════════════════════════════
 
-export async function createMatch(venueId, matchType, teamA, teamB) {
+export async function createMatch(venueId, matchType, teamA, teamB, mode = 'standard', servers = null, bestOf = null) {
+    const body = {
+        venue_id: venueId,
+        match_type: matchType,
+        mode,
+        team_a: teamA,
+        team_b: teamB,
+    };
+    if (mode === 'short') {
+        body.servers = servers;
+        body.best_of = bestOf || 3;
+    }
+
     return await request('/api/matches', {
         method: 'POST',
-        body: {
-            venue_id: venueId,
-            match_type: matchType,
-            team_a: teamA,
-            team_b: teamB,
-        },
+        body,
     });
 }
 
 export async function submitEvents(matchId, events) {
     const formattedEvents = events.map(e => ({
         id: e.id,
+        seq: e.seq,
         timestamp: e.timestamp,
         server_player_id: e.serverPlayerId,
         serve_type: e.serveType,
@@ -177,7 +185,8 @@ export async function getMatchSummary(matchId) {
 }
 
 export async function getMatches() {
-    return await request('/api/admin/matches');
+    const page = await request('/api/admin/matches');
+    return page.matches.map((entry) => entry.match);
 }
 
 export async function deleteMatch(matchId) {
@@ -2,7 +2,7 @@
 import { openDB } from 'idb';
 
 const DB_NAME = 'ots-db';
-const DB_VERSION = 3; // Bumped for temp players support
+const DB_VERSION = 4; // Bumped for event sequence counters
 
 // Match expiry time: 1 day in milliseconds
 const MATCH_EXPIRY_MS = 24 * 60 * 60 * 1000;
@@ -33,6 +33,11 @@ export function getDB() {
                     eventStore.createIndex('synced', 'synced');
                 }
 
+                // Next event sequence number per match (never reused, even after undo)
+                if (!db.objectStoreNames.contains('eventSeqs')) {
+                    db.createObjectStore('eventSeqs', { keyPath: 'matchId' });
+                }
+
                 // Cache for players
                 if (!db.objectStoreNames.contains('players')) {
                     db.createObjectStore('players', { keyPath: 'id' });
@@ -182,6 +187,28 @@ export async function saveEvent(event) {
     await db.put('events', { ...event, synced: false });
 }
 
+// Returns the sequence number for a match's next event. The counter only
+// goes up: the server keeps points it has received even when they are undone
+// here, so reusing their numbers would be rejected as taken.
+export async function nextEventSeq(matchId) {
+    const db = await getDB();
+    const tx = db.transaction(['eventSeqs', 'events'], 'readwrite');
+    const counters = tx.objectStore('eventSeqs');
+
+    let counter = await counters.get(matchId);
+    if (!counter) {
+        // Matches started before the counter existed continue from their events
+        const events = await tx.objectStore('events').index('matchId').getAll(matchId);
+        const last = events.reduce((max, e) => Math.max(max, e.seq ?? 0), 0);
+        counter = { matchId, next: last + 1 };
+    }
+
+    const seq = counter.next;
+    await counters.put({ matchId, next: seq + 1 });
+    await tx.done;
+    return seq;
+}
+
 export async function getUnsyncedEvents(matchId) {
     const db = await getDB();
     const tx = db.transaction('events', 'readonly');
@@ -203,8 +230,8 @@ export async function deleteLastEvent(matchId) {
     const index = tx.store.index('matchId');
     const events = await index.getAll(matchId);
     if (events.length > 0) {
-        // Sort by timestamp and delete the last one
-        events.sort((a, b) => new Date(a.timestamp) - new Date(b.timestamp));
+        // Sort by sequence (timestamp for events recorded before seq) and delete the last one
+        events.sort((a, b) => (a.seq ?? 0) - (b.seq ?? 0) || new Date(a.timestamp) - new Date(b.timestamp));
         const lastEvent = events[events.length - 1];
         await tx.store.delete(lastEvent.id);
         await tx.done;
@@ -228,12 +255,13 @@ export async function markEventsSynced(eventIds) {
 
 export async function clearMatchEvents(matchId) {
     const db = await getDB();
-    const tx = db.transaction('events', 'readwrite');
-    const index = tx.store.index('matchId');
-    const events = await index.getAll(matchId);
+    const tx = db.transaction(['events', 'eventSeqs'], 'readwrite');
+    const store = tx.objectStore('events');
+    const events = await store.index('matchId').getAll(matchId);
     for (const event of events) {
-        await tx.store.delete(event.id);
+        await store.delete(event.id);
     }
+    await tx.objectStore('eventSeqs').delete(matchId);
     await tx.done;
 }
 
@@ -260,7 +260,8 @@ function handleShortFormatGameWon(state, winner) {
         pointsA: 0,
         pointsB: 0,
         gameNumber: state.currentGame.gameNumber + 1,
-        serverIndex: state.currentGame.serverIndex + 1
+        // Start the serving order again once every server has served
+        serverIndex: (state.currentGame.serverIndex + 1) % state.servers.length
     };
 
     return state;